@@ -4,8 +4,10 @@ go 1.12
 
 require (
 	github.com/fatih/structs v1.1.0
+	github.com/hashicorp/go-uuid v1.0.1
 	github.com/hashicorp/terraform v0.12.10
 	github.com/hashicorp/yamux v0.0.0-20181012175058-2f1d1f20f75d // indirect
+	github.com/kevinburke/rest v0.0.0-20200429221318-0d2892b400f8
 	github.com/kevinburke/twilio-go v0.0.0-20190630185733-fe05957cdaf8
 	github.com/konsorten/go-windows-terminal-sequences v1.0.2 // indirect
 	github.com/onsi/ginkgo v1.10.2
@@ -0,0 +1,290 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/kevinburke/twilio-go"
+	"github.com/spf13/cast"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// availablePhoneNumber extends twilio.AvailableNumber with the attributes the twilio-go model does not map.
+type availablePhoneNumber struct {
+	twilio.AvailableNumber
+	Locality     string                 `json:"locality"`
+	Capabilities *phoneNumberCapability `json:"capabilities"`
+}
+
+type availablePhoneNumberPage struct {
+	listPage
+	Numbers []*availablePhoneNumber `json:"available_phone_numbers"`
+}
+
+func dataTwilioAvailablePhoneNumbers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataTwilioAvailablePhoneNumbersRead,
+
+		Schema: map[string]*schema.Schema{
+			"country_code": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Two letter ISO country code in which you want to search for a number.",
+			},
+			"type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "Local",
+				ValidateFunc: validation.StringInSlice([]string{
+					"Local",
+					"Mobile",
+					"TollFree",
+				}, false),
+			},
+			"area_code": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Look for numbers within this area code.",
+			},
+			"search": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Look for this number sequence anywhere in the phone number.",
+			},
+			"sms_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return numbers that can (or, if `false`, cannot) receive SMS.",
+			},
+			"mms_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return numbers that can (or, if `false`, cannot) receive MMS.",
+			},
+			"voice_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return numbers that can (or, if `false`, cannot) receive calls.",
+			},
+			"fax_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return numbers that can (or, if `false`, cannot) receive faxes.",
+			},
+			"limit": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      20,
+				ValidateFunc: validation.IntBetween(1, 1000),
+				Description:  "The maximum number of candidates to return. Defaults to `20`.",
+			},
+			"numbers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"number": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The full phone number in E.164 format.",
+						},
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"locality": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"postal_code": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"iso_country": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"latitude": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"longitude": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rate_center": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"lata": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"address_requirements": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"is_beta": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_mms_capable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_sms_capable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_voice_capable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"is_fax_capable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// makeAvailableNumberSearchParams builds the AvailablePhoneNumbers query shared by searches and purchases.
+func makeAvailableNumberSearchParams(d *schema.ResourceData) url.Values {
+	searchParams := make(url.Values)
+
+	addIfNotEmpty(searchParams, "AreaCode", d.Get("area_code"))
+	addIfNotEmpty(searchParams, "Contains", d.Get("search"))
+
+	return searchParams
+}
+
+// searchAvailablePhoneNumbers pages through the AvailablePhoneNumbers list for the given country and number type
+// until `limit` candidates have been collected or no pages remain.
+func searchAvailablePhoneNumbers(ctx context.Context, client *twilio.Client, countryCode string, numType string, searchParams url.Values, limit int) ([]*availablePhoneNumber, error) {
+	params := make(url.Values)
+	for key, values := range searchParams {
+		params[key] = values
+	}
+	params.Set("PageSize", cast.ToString(limit))
+
+	path := fmt.Sprintf("AvailablePhoneNumbers/%s/%s", countryCode, numType)
+	iter := newPageIterator(client, path, params)
+
+	numbers := make([]*availablePhoneNumber, 0, limit)
+	for len(numbers) < limit {
+		page := new(availablePhoneNumberPage)
+		if err := iter.Next(ctx, page); err == twilio.NoMoreResults {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+		numbers = append(numbers, page.Numbers...)
+
+		if len(page.Numbers) == 0 {
+			break
+		}
+	}
+
+	if len(numbers) > limit {
+		numbers = numbers[:limit]
+	}
+	return numbers, nil
+}
+
+func flattenAvailablePhoneNumber(number *availablePhoneNumber) map[string]interface{} {
+	m := map[string]interface{}{
+		"number":               string(number.PhoneNumber),
+		"friendly_name":        number.FriendlyName,
+		"locality":             number.Locality,
+		"region":               number.Region,
+		"postal_code":          number.PostalCode,
+		"iso_country":          number.ISOCountry,
+		"latitude":             number.Latitude,
+		"longitude":            number.Longitude,
+		"rate_center":          number.RateCenter,
+		"lata":                 number.Lata,
+		"address_requirements": number.AddressRequirements,
+		"is_beta":              number.Beta,
+	}
+	if number.Capabilities != nil {
+		m["is_mms_capable"] = number.Capabilities.MMS
+		m["is_sms_capable"] = number.Capabilities.SMS
+		m["is_voice_capable"] = number.Capabilities.Voice
+		m["is_fax_capable"] = number.Capabilities.Fax
+	}
+	return m
+}
+
+func dataTwilioAvailablePhoneNumbersRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER dataTwilioAvailablePhoneNumbersRead")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	ctx := context.TODO()
+
+	countryCode := d.Get("country_code").(string)
+	numType := d.Get("type").(string)
+	limit := d.Get("limit").(int)
+
+	searchParams := makeAvailableNumberSearchParams(d)
+	for attribute, param := range map[string]string{
+		"sms_enabled":   "SmsEnabled",
+		"mms_enabled":   "MmsEnabled",
+		"voice_enabled": "VoiceEnabled",
+		"fax_enabled":   "FaxEnabled",
+	} {
+		if v, ok := d.GetOkExists(attribute); ok {
+			searchParams.Set(param, cast.ToString(v))
+		}
+	}
+
+	id := fmt.Sprintf("%s/%s/%d", countryCode, numType, hashcode.String(searchParams.Encode()+cast.ToString(limit)))
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":  config.AccountSID,
+			"country_code": countryCode,
+			"limit":        limit,
+		},
+	).Debug(fmt.Sprintf("START client.AvailableNumbers.%s.GetPage", numType))
+
+	numbers, err := searchAvailablePhoneNumbers(ctx, client, countryCode, numType, searchParams, limit)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid":  config.AccountSID,
+				"country_code": countryCode,
+			},
+		).WithError(err).Error(fmt.Sprintf("ERROR client.AvailableNumbers.%s.GetPage", numType))
+
+		return fmt.Errorf("Encountered an error when searching for available phone numbers in %s: %s", countryCode, err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":  config.AccountSID,
+			"country_code": countryCode,
+			"result_count": len(numbers),
+		},
+	).Debug(fmt.Sprintf("END client.AvailableNumbers.%s.GetPage", numType))
+
+	candidates := make([]map[string]interface{}, 0, len(numbers))
+	for _, number := range numbers {
+		candidates = append(candidates, flattenAvailablePhoneNumber(number))
+	}
+
+	d.SetId(id)
+	return d.Set("numbers", candidates)
+}
@@ -0,0 +1,36 @@
+package twilio
+
+import (
+	"context"
+	"net/url"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("searchAvailablePhoneNumbers", func() {
+	var fake *fakeTwilio
+
+	BeforeEach(func() {
+		fake = newFakeTwilio(map[string]string{
+			"GET /2010-04-01/Accounts/AC123/AvailablePhoneNumbers/US/Local.json": `{"available_phone_numbers": [{"phone_number": "+15550001111"}, {"phone_number": "+15550002222"}]}`,
+		})
+	})
+
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	It("pages with the requested limit without modifying the caller's search", func() {
+		searchParams := url.Values{"AreaCode": []string{"555"}}
+
+		numbers, err := searchAvailablePhoneNumbers(context.TODO(), fake.meta().client, "US", "Local", searchParams, 2)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(numbers).To(HaveLen(2))
+
+		form := fake.forms["GET /2010-04-01/Accounts/AC123/AvailablePhoneNumbers/US/Local.json"]
+		Expect(form.Get("AreaCode")).To(Equal("555"))
+		Expect(form.Get("PageSize")).To(Equal("2"))
+		Expect(searchParams).To(Equal(url.Values{"AreaCode": []string{"555"}}))
+	})
+})
@@ -1,70 +1,114 @@
 package twilio
 
 import (
-    "context"
-    "errors"
-    "fmt"
-    "github.com/hashicorp/terraform/helper/schema"
-    log "github.com/sirupsen/logrus"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevinburke/twilio-go"
+
+	log "github.com/sirupsen/logrus"
 )
 
+type messagingServicePage struct {
+	listPage
+	Services []*messagingService `json:"services"`
+}
+
 func dataTwilioMessagingService() *schema.Resource {
-    s := makeComputed(resourceTwilioMessagingService().Schema)
-    s["friendly_name"].Required = true
-    s["friendly_name"].Computed = false
-
-    return &schema.Resource{
-        Read:   dataTwilioMessagingServiceRead,
-        Schema: s,
-    }
+	s := makeComputed(resourceTwilioMessagingService().Schema)
+	s["friendly_name"].Required = true
+	s["friendly_name"].Computed = false
+	delete(s, "detach_senders_on_destroy")
+	delete(s, "deletion_protection")
+
+	return &schema.Resource{
+		Read:   dataTwilioMessagingServiceRead,
+		Schema: s,
+	}
+}
+
+// listMessagingServices returns every messaging service of the configured account.
+func listMessagingServices(ctx context.Context, client *twilio.Client) ([]*messagingService, error) {
+	iter := newPageIterator(client.Message, "Services", url.Values{"PageSize": []string{"1000"}})
+
+	services := make([]*messagingService, 0)
+	for {
+		page := new(messagingServicePage)
+		if err := iter.Next(ctx, page); err == twilio.NoMoreResults {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		services = append(services, page.Services...)
+	}
+	return services, nil
 }
 
 func dataTwilioMessagingServiceRead(d *schema.ResourceData, meta interface{}) error {
-    log.Debug("ENTER dataTwilioMessagingServiceRead")
-
-    client := meta.(*TerraformTwilioContext).client
-    config := meta.(*TerraformTwilioContext).configuration
-    ctx := context.TODO()
-
-    query := make(map[string][]string)
-
-    friendlyName := ""
-    if f, ok := d.GetOkExists("friendly_name"); ok && len(f.(string)) > 0 {
-        friendlyName = f.(string)
-        query["FriendlyName"] = []string{friendlyName}
-    }
-
-    if friendlyName == "" {
-        return errors.New("'friendly_name' must be specified")
-    }
-
-    log.WithFields(
-        log.Fields{
-            "account_sid": config.AccountSID,
-            "friendly_name":      friendlyName,
-        },
-    ).Debug("START client.Message.Services.GetPage")
-
-    if page, err := client.Message.Services.GetPage(ctx, query); err != nil {
-        log.WithFields(
-            log.Fields{
-                "account_sid": config.AccountSID,
-                "friendly_name":      friendlyName,
-            },
-        ).Debug("END client.Message.Services.GetPage")
-        return fmt.Errorf("unable to find MessagingService with friendlyName: %s\nerror: %s", friendlyName, err.Error())
-    } else {
-        for _, service := range page.Services {
-            d.SetId(service.Sid)
-            log.WithFields(
-                log.Fields{
-                    "account_sid": config.AccountSID,
-                    "service_sid":                d.Id(),
-                },
-            ).Debug("END client.Message.Services.GetPage")
-            return mapTwilioMessagingServiceToTerraform(service, d)
-        }
-        err = fmt.Errorf("unable to find MessagingService with friendlyName: %s", friendlyName)
-        return err
-    }
+	log.Debug("ENTER dataTwilioMessagingServiceRead")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	ctx := context.TODO()
+
+	friendlyName := ""
+	if f, ok := d.GetOkExists("friendly_name"); ok && len(f.(string)) > 0 {
+		friendlyName = f.(string)
+	}
+
+	if friendlyName == "" {
+		return errors.New("'friendly_name' must be specified")
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":   config.AccountSID,
+			"friendly_name": friendlyName,
+		},
+	).Debug("START client.Message.Services.GetPageIterator")
+
+	services, err := listMessagingServices(ctx, client)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid":   config.AccountSID,
+				"friendly_name": friendlyName,
+			},
+		).WithError(err).Error("ERROR client.Message.Services.GetPageIterator")
+		return fmt.Errorf("unable to find MessagingService with friendlyName: %s\nerror: %s", friendlyName, err.Error())
+	}
+
+	// The Services list does not filter by friendly name, so every service is matched here.
+	var found []*messagingService
+	for _, service := range services {
+		if service.FriendlyName == friendlyName {
+			found = append(found, service)
+		}
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":   config.AccountSID,
+			"friendly_name": friendlyName,
+			"match_count":   len(found),
+		},
+	).Debug("END client.Message.Services.GetPageIterator")
+
+	if len(found) == 0 {
+		return fmt.Errorf("unable to find MessagingService with friendlyName: %s", friendlyName)
+	}
+	if len(found) > 1 {
+		sids := make([]string, 0, len(found))
+		for _, service := range found {
+			sids = append(sids, service.Sid)
+		}
+		return ambiguousMatchError("MessagingServices", "friendlyName: "+friendlyName, sids)
+	}
+
+	d.SetId(found[0].Sid)
+	return mapTwilioMessagingServiceToTerraform(found[0], d)
 }
@@ -4,12 +4,14 @@ import (
 	"context"
 	"errors"
 	"fmt"
+
 	"github.com/hashicorp/terraform/helper/schema"
+
 	log "github.com/sirupsen/logrus"
 )
 
 func dataTwilioPhoneNumber() *schema.Resource {
-	s := makeComputed(resourceTwilioPhoneNumber().Schema)
+	s := dataTwilioPhoneNumberSchema()
 	s["friendly_name"].Optional = true
 	s["number"].Optional = true
 
@@ -49,36 +51,53 @@ func dataTwilioPhoneNumberRead(d *schema.ResourceData, meta interface{}) error {
 			"friendly_name":      friendlyName,
 			"number":             number,
 		},
-	).Debug("START client.IncomingNumbers.GetPage")
-
-	if page, err := client.IncomingNumbers.GetPage(context, query); err != nil {
-        log.WithFields(
-            log.Fields{
-                "parent_account_sid": config.AccountSID,
-                "friendly_name":      friendlyName,
-            },
-        ).Debug("END client.Accounts.GetPage")
+	).Debug("START client.IncomingNumbers.GetPageIterator")
+
+	numbers, err := listIncomingPhoneNumbers(context, client, "", query)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"parent_account_sid": config.AccountSID,
+				"friendly_name":      friendlyName,
+			},
+		).WithError(err).Error("ERROR client.IncomingNumbers.GetPageIterator")
 		return fmt.Errorf("unable to find IncomingPhoneNumber with friendlyName: %s\nerror: %s", friendlyName, err.Error())
+	}
+
+	var found []*incomingPhoneNumber
+	for _, incNumber := range numbers {
+		if (friendlyName == "" || incNumber.FriendlyName == friendlyName) && (number == "" || string(incNumber.PhoneNumber) == number) {
+			found = append(found, incNumber)
+		}
+	}
+
+	log.WithFields(
+		log.Fields{
+			"parent_account_sid": config.AccountSID,
+			"match_count":        len(found),
+		},
+	).Debug("END client.IncomingNumbers.GetPageIterator")
+
+	var description string
+	if friendlyName == "" {
+		description = fmt.Sprintf("number: %s", number)
+	} else if number == "" {
+		description = fmt.Sprintf("friendlyName: %s", friendlyName)
 	} else {
-	    for _, incNumber := range page.IncomingPhoneNumbers {
-            if (friendlyName == "" || incNumber.FriendlyName == friendlyName) && (number == "" || string(incNumber.PhoneNumber) == number) {
-                d.SetId(incNumber.Sid)
-                log.WithFields(
-                    log.Fields{
-                        "parent_account_sid": config.AccountSID,
-                        "sid":                d.Id(),
-                    },
-                ).Debug("END client.IncomingNumbers.GetPage")
-                return mapTwilioPhoneNumberToTerraform(incNumber, d)
-            }
-        }
-        if friendlyName == "" {
-            err = fmt.Errorf("unable to find IncomingPhoneNumber with number: %s", number)
-        } else if number == "" {
-            err = fmt.Errorf("unable to find IncomingPhoneNumber with friendlyName: %s", friendlyName)
-        } else {
-            err = fmt.Errorf("unable to find IncomingPhoneNumber with number: %s and friendlyName: %s", number, friendlyName)
-        }
-        return err
+		description = fmt.Sprintf("number: %s and friendlyName: %s", number, friendlyName)
 	}
+
+	if len(found) == 0 {
+		return fmt.Errorf("unable to find IncomingPhoneNumber with %s", description)
+	}
+	if len(found) > 1 {
+		sids := make([]string, 0, len(found))
+		for _, incNumber := range found {
+			sids = append(sids, incNumber.Sid)
+		}
+		return ambiguousMatchError("IncomingPhoneNumbers", description, sids)
+	}
+
+	d.SetId(found[0].Sid)
+	return mapTwilioPhoneNumberToTerraform(found[0], d)
 }
@@ -0,0 +1,333 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/kevinburke/twilio-go"
+	"github.com/spf13/cast"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// phoneNumberPurchaseAttributes lists the twilio_phone_number arguments that only control how a number is purchased
+// or destroyed, which are left out of the twilio_phone_number and twilio_phone_numbers data sources.
+var phoneNumberPurchaseAttributes = []string{
+	"search",
+	"area_code",
+	"type",
+	"country_code",
+	"existing_number",
+	"phone_number",
+	"release_on_destroy",
+	"on_destroy",
+	"park_friendly_name",
+	"deletion_protection",
+	"replace_on_search_change",
+	"service_sid",
+}
+
+type messagingServicePhoneNumberPage struct {
+	listPage
+	PhoneNumbers []*twilio.ServicePhoneNumber `json:"phone_numbers"`
+}
+
+// dataTwilioPhoneNumberSchema is the computed twilio_phone_number schema without its purchase and destroy arguments,
+// shared by the twilio_phone_number data source and the elements of twilio_phone_numbers.
+func dataTwilioPhoneNumberSchema() map[string]*schema.Schema {
+	s := makeComputed(resourceTwilioPhoneNumber().Schema)
+	for _, key := range phoneNumberPurchaseAttributes {
+		delete(s, key)
+	}
+	return s
+}
+
+func dataTwilioPhoneNumbers() *schema.Resource {
+	return &schema.Resource{
+		Read: dataTwilioPhoneNumbersRead,
+
+		Schema: map[string]*schema.Schema{
+			"account_sid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "SID of the account (or subaccount) whose numbers are listed. Defaults to the provider's account.",
+			},
+			"friendly_name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"friendly_name_regex"},
+				Description:   "Only return numbers whose friendly name starts with this prefix.",
+			},
+			"friendly_name_regex": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.ValidateRegexp,
+				ConflictsWith: []string{"friendly_name_prefix"},
+				Description:   "Only return numbers whose friendly name matches this regular expression.",
+			},
+			"number_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return numbers whose E.164 representation starts with this prefix, e.g. `+1415`.",
+			},
+			"sms_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return numbers that can (or, if `false`, cannot) receive SMS.",
+			},
+			"mms_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return numbers that can (or, if `false`, cannot) receive MMS.",
+			},
+			"voice_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return numbers that can (or, if `false`, cannot) receive calls.",
+			},
+			"fax_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Only return numbers that can (or, if `false`, cannot) receive faxes.",
+			},
+			"messaging_service_sid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return numbers in the sender pool of this messaging service.",
+			},
+			"trunk_sid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return numbers routed to this voice trunk.",
+			},
+			"origin": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"twilio",
+					"hosted",
+				}, false),
+				Description: "Only return numbers with this origin. Can be `twilio` or `hosted`.",
+			},
+			"sids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The SIDs of the matching numbers.",
+			},
+			"numbers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The E.164 representation of the matching numbers, in the same order as `sids`.",
+			},
+			"phone_numbers": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: dataTwilioPhoneNumberSchema(),
+				},
+				Description: "The matching numbers, with the same attributes as the `twilio_phone_number` data source.",
+			},
+		},
+	}
+}
+
+// listMessagingServicePhoneNumberSids returns the SIDs of every number in a messaging service's sender pool.
+func listMessagingServicePhoneNumberSids(ctx context.Context, client *twilio.Client, serviceSid string) (map[string]bool, error) {
+	data := make(url.Values)
+	data.Set("PageSize", "1000")
+
+	iter := newPageIterator(client.Message, "Services/"+serviceSid+"/PhoneNumbers", data)
+
+	sids := make(map[string]bool)
+	for {
+		page := new(messagingServicePhoneNumberPage)
+		if err := iter.Next(ctx, page); err == twilio.NoMoreResults {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		for _, number := range page.PhoneNumbers {
+			sids[number.Sid] = true
+		}
+	}
+	return sids, nil
+}
+
+// flattenIncomingPhoneNumber maps a number onto the element schema of twilio_phone_numbers by way of the same mapping
+// used by the twilio_phone_number resource and data source.
+func flattenIncomingPhoneNumber(ph *incomingPhoneNumber, s map[string]*schema.Schema) (map[string]interface{}, error) {
+	d := (&schema.Resource{Schema: s}).Data(nil)
+	if err := mapTwilioPhoneNumberToTerraform(ph, d); err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]interface{}, len(s))
+	for key := range s {
+		m[key] = d.Get(key)
+	}
+	return m, nil
+}
+
+func dataTwilioPhoneNumbersRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER dataTwilioPhoneNumbersRead")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	ctx := context.TODO()
+
+	accountSid := d.Get("account_sid").(string)
+	friendlyNamePrefix := d.Get("friendly_name_prefix").(string)
+	numberPrefix := d.Get("number_prefix").(string)
+	serviceSid := d.Get("messaging_service_sid").(string)
+	trunkSid := d.Get("trunk_sid").(string)
+
+	var friendlyNameRegex *regexp.Regexp
+	if r := d.Get("friendly_name_regex").(string); r != "" {
+		var err error
+		if friendlyNameRegex, err = regexp.Compile(r); err != nil {
+			return fmt.Errorf("Invalid friendly_name_regex %q: %s", r, err)
+		}
+	}
+
+	capabilities := make(map[string]bool)
+	for _, capability := range []string{"sms", "mms", "voice", "fax"} {
+		if v, ok := d.GetOkExists(capability + "_enabled"); ok {
+			capabilities[capability] = v.(bool)
+		}
+	}
+
+	query := make(url.Values)
+	addIfNotEmpty(query, "Origin", d.Get("origin"))
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"owner_sid":   accountSid,
+		},
+	).Debug("START client.IncomingNumbers.GetPageIterator")
+
+	all, err := listIncomingPhoneNumbers(ctx, client, accountSid, query)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"owner_sid":   accountSid,
+			},
+		).WithError(err).Error("ERROR client.IncomingNumbers.GetPageIterator")
+
+		return fmt.Errorf("Encountered an error when listing phone numbers: %s", err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":  config.AccountSID,
+			"owner_sid":    accountSid,
+			"result_count": len(all),
+		},
+	).Debug("END client.IncomingNumbers.GetPageIterator")
+
+	var serviceNumberSids map[string]bool
+	if serviceSid != "" {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"service_sid": serviceSid,
+			},
+		).Debug("START client.Message.Services.GetPhoneNumberPageIterator")
+
+		if serviceNumberSids, err = listMessagingServicePhoneNumberSids(ctx, client, serviceSid); err != nil {
+			return fmt.Errorf("Encountered an error when listing the phone numbers of messaging service %s: %s", serviceSid, err)
+		}
+
+		log.WithFields(
+			log.Fields{
+				"account_sid":  config.AccountSID,
+				"service_sid":  serviceSid,
+				"result_count": len(serviceNumberSids),
+			},
+		).Debug("END client.Message.Services.GetPhoneNumberPageIterator")
+	}
+
+	elemSchema := dataTwilioPhoneNumberSchema()
+
+	sids := make([]string, 0)
+	numbers := make([]string, 0)
+	phoneNumbers := make([]map[string]interface{}, 0)
+	for _, ph := range all {
+		if friendlyNamePrefix != "" && !strings.HasPrefix(ph.FriendlyName, friendlyNamePrefix) {
+			continue
+		}
+		if friendlyNameRegex != nil && !friendlyNameRegex.MatchString(ph.FriendlyName) {
+			continue
+		}
+		if numberPrefix != "" && !strings.HasPrefix(string(ph.PhoneNumber), numberPrefix) {
+			continue
+		}
+		if trunkSid != "" && ph.TrunkSid.String != trunkSid {
+			continue
+		}
+		if serviceNumberSids != nil && !serviceNumberSids[ph.Sid] {
+			continue
+		}
+		if !phoneNumberHasCapabilities(ph, capabilities) {
+			continue
+		}
+
+		m, err := flattenIncomingPhoneNumber(ph, elemSchema)
+		if err != nil {
+			return fmt.Errorf("Encountered an error while mapping phone number SID %s to terraform: %s", ph.Sid, err)
+		}
+		sids = append(sids, ph.Sid)
+		numbers = append(numbers, string(ph.PhoneNumber))
+		phoneNumbers = append(phoneNumbers, m)
+	}
+
+	filters := []string{accountSid, friendlyNamePrefix, d.Get("friendly_name_regex").(string), numberPrefix, serviceSid, trunkSid, query.Encode()}
+	for _, capability := range []string{"sms", "mms", "voice", "fax"} {
+		if enabled, ok := capabilities[capability]; ok {
+			filters = append(filters, capability+"="+cast.ToString(enabled))
+		}
+	}
+	d.SetId(fmt.Sprintf("%s/%d", config.AccountSID, hashcode.String(strings.Join(filters, "/"))))
+
+	err = d.Set("sids", sids)
+	if err == nil {
+		err = d.Set("numbers", numbers)
+	}
+	if err == nil {
+		err = d.Set("phone_numbers", phoneNumbers)
+	}
+	return err
+}
+
+// phoneNumberHasCapabilities reports whether each capability of ph matches the wanted value.
+func phoneNumberHasCapabilities(ph *incomingPhoneNumber, capabilities map[string]bool) bool {
+	if len(capabilities) == 0 {
+		return true
+	}
+	if ph.Capabilities == nil {
+		return false
+	}
+
+	actual := map[string]bool{
+		"sms":   ph.Capabilities.SMS,
+		"mms":   ph.Capabilities.MMS,
+		"voice": ph.Capabilities.Voice,
+		"fax":   ph.Capabilities.Fax,
+	}
+	for capability, enabled := range capabilities {
+		if actual[capability] != enabled {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,223 @@
+package twilio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevinburke/twilio-go"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// shortCode is an SMS short code owned by an account. The twilio-go client has no model for it.
+type shortCode struct {
+	Sid               string            `json:"sid"`
+	AccountSid        string            `json:"account_sid"`
+	ShortCode         string            `json:"short_code"`
+	FriendlyName      string            `json:"friendly_name"`
+	SmsURL            string            `json:"sms_url"`
+	SmsMethod         string            `json:"sms_method"`
+	SmsFallbackURL    string            `json:"sms_fallback_url"`
+	SmsFallbackMethod string            `json:"sms_fallback_method"`
+	DateCreated       twilio.TwilioTime `json:"date_created"`
+	DateUpdated       twilio.TwilioTime `json:"date_updated"`
+}
+
+type shortCodePage struct {
+	listPage
+	ShortCodes []*shortCode `json:"short_codes"`
+}
+
+// shortCodesPath returns the full path of the SMS/ShortCodes list owned by accountSid. An empty accountSid refers to the
+// configured account.
+func shortCodesPath(client *twilio.Client, accountSid string, sid string) string {
+	if accountSid == "" {
+		accountSid = client.AccountSid
+	}
+	path := []string{"", client.APIVersion, "Accounts", accountSid, "SMS", "ShortCodes"}
+	if sid != "" {
+		path = append(path, sid)
+	}
+	return strings.Join(path, "/") + ".json"
+}
+
+func dataTwilioShortCode() *schema.Resource {
+	return &schema.Resource{
+		Read: dataTwilioShortCodeRead,
+
+		Schema: map[string]*schema.Schema{
+			"sid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "SID of the short code. Either this or `short_code` must be set.",
+			},
+			"short_code": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The short code, e.g. `894546`. Either this or `sid` must be set.",
+			},
+			"account_sid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "SID of the account (or subaccount) that owns the short code. Defaults to the provider's account.",
+			},
+			"friendly_name": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sms_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sms_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sms_fallback_url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sms_fallback_method": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func mapTwilioShortCodeToTerraform(sc *shortCode, d *schema.ResourceData) error {
+	err := d.Set("sid", sc.Sid)
+	if err == nil {
+		err = d.Set("short_code", sc.ShortCode)
+	}
+	if err == nil {
+		err = d.Set("account_sid", sc.AccountSid)
+	}
+	if err == nil {
+		err = d.Set("friendly_name", sc.FriendlyName)
+	}
+	if err == nil {
+		err = d.Set("sms_url", sc.SmsURL)
+	}
+	if err == nil {
+		err = d.Set("sms_method", sc.SmsMethod)
+	}
+	if err == nil {
+		err = d.Set("sms_fallback_url", sc.SmsFallbackURL)
+	}
+	if err == nil {
+		err = d.Set("sms_fallback_method", sc.SmsFallbackMethod)
+	}
+	if err == nil && sc.DateCreated.Valid {
+		err = d.Set("date_created", sc.DateCreated.Time.Format("2006-01-02T15:04:05-07:00"))
+	}
+	if err == nil && sc.DateUpdated.Valid {
+		err = d.Set("date_updated", sc.DateUpdated.Time.Format("2006-01-02T15:04:05-07:00"))
+	}
+	return err
+}
+
+// findShortCode looks up a short code by SID or, if sid is empty, by the code itself.
+func findShortCode(ctx context.Context, client *twilio.Client, accountSid string, sid string, code string) (*shortCode, error) {
+	if sid != "" {
+		sc := new(shortCode)
+		err := client.MakeRequest(ctx, "GET", shortCodesPath(client, accountSid, sid), nil, sc)
+		return sc, err
+	}
+
+	iter := newPageIterator(client, shortCodesPath(client, accountSid, ""), url.Values{"ShortCode": []string{code}})
+
+	var found []*shortCode
+	for {
+		page := new(shortCodePage)
+		if err := iter.Next(ctx, page); err == twilio.NoMoreResults {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		for _, sc := range page.ShortCodes {
+			if sc.ShortCode == code {
+				found = append(found, sc)
+			}
+		}
+	}
+
+	if len(found) == 0 {
+		return nil, fmt.Errorf("unable to find ShortCode with shortCode: %s", code)
+	}
+	if len(found) > 1 {
+		sids := make([]string, 0, len(found))
+		for _, sc := range found {
+			sids = append(sids, sc.Sid)
+		}
+		return nil, ambiguousMatchError("ShortCodes", "shortCode: "+code, sids)
+	}
+	return found[0], nil
+}
+
+func dataTwilioShortCodeRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER dataTwilioShortCodeRead")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	ctx := context.TODO()
+
+	sid := d.Get("sid").(string)
+	code := d.Get("short_code").(string)
+	accountSid := d.Get("account_sid").(string)
+
+	if sid == "" && code == "" {
+		return errors.New("'sid' and/or 'short_code' must be specified")
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"sid":         sid,
+			"short_code":  code,
+		},
+	).Debug("START client.ShortCodes.Get")
+
+	sc, err := findShortCode(ctx, client, accountSid, sid, code)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"sid":         sid,
+				"short_code":  code,
+			},
+		).WithError(err).Error("ERROR client.ShortCodes.Get")
+
+		return fmt.Errorf("Encountered an error when looking up short code: %s", err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"sid":         sc.Sid,
+		},
+	).Debug("END client.ShortCodes.Get")
+
+	if code != "" && sc.ShortCode != code {
+		return fmt.Errorf("Short code SID %s is %s, not %s", sc.Sid, sc.ShortCode, code)
+	}
+
+	d.SetId(sc.Sid)
+	return mapTwilioShortCodeToTerraform(sc, d)
+}
@@ -3,14 +3,27 @@ package twilio
 import (
 	"context"
 	"fmt"
+	"net/url"
+
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevinburke/twilio-go"
+
 	log "github.com/sirupsen/logrus"
 )
 
+type accountPage struct {
+	listPage
+	Accounts []*twilio.Account `json:"accounts"`
+}
+
 func dataTwilioSubaccount() *schema.Resource {
 	s := makeComputed(resourceTwilioSubaccount().Schema)
 	s["friendly_name"].Required = true
 	s["friendly_name"].Computed = false
+	delete(s, "on_destroy")
+	delete(s, "force_close")
+	delete(s, "deletion_protection")
+	delete(s, "store_auth_token")
 
 	return &schema.Resource{
 		Read:   dataTwilioSubaccountRead,
@@ -18,6 +31,29 @@ func dataTwilioSubaccount() *schema.Resource {
 	}
 }
 
+// listAccounts returns every account visible to the configured account that matches data.
+func listAccounts(ctx context.Context, client *twilio.Client, data url.Values) ([]*twilio.Account, error) {
+	if data == nil {
+		data = make(url.Values)
+	}
+	data.Set("PageSize", "1000")
+
+	iter := newPageIterator(client, "/"+client.APIVersion+"/Accounts.json", data)
+
+	accounts := make([]*twilio.Account, 0)
+	for {
+		page := new(accountPage)
+		if err := iter.Next(ctx, page); err == twilio.NoMoreResults {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		accounts = append(accounts, page.Accounts...)
+	}
+	return accounts, nil
+}
+
 func dataTwilioSubaccountRead(d *schema.ResourceData, meta interface{}) error {
 	log.Debug("ENTER dataTwilioSubaccountRead")
 
@@ -32,43 +68,56 @@ func dataTwilioSubaccountRead(d *schema.ResourceData, meta interface{}) error {
 			"parent_account_sid": config.AccountSID,
 			"friendly_name":      friendlyName,
 		},
-	).Debug("START client.Accounts.GetPage")
+	).Debug("START client.Accounts.GetPageIterator")
 
-	if page, err := client.Accounts.GetPage(context, map[string][]string{
-		"FriendlyName": {friendlyName},
-	}); err != nil {
+	accounts, err := listAccounts(context, client, url.Values{"FriendlyName": []string{friendlyName}})
+	if err != nil {
 		log.WithFields(
 			log.Fields{
 				"parent_account_sid": config.AccountSID,
 				"friendly_name":      friendlyName,
 			},
-		).WithError(err).Error("ERROR client.Accounts.GetPage")
+		).WithError(err).Error("ERROR client.Accounts.GetPageIterator")
 		return fmt.Errorf("unable to find subaccount with friendlyName: %s\nerror: %s", friendlyName, err.Error())
-	} else {
-	    for _, account := range page.Accounts {
-	        if account.FriendlyName == friendlyName {
-                d.SetId(account.Sid)
-                if err = mapTwilioSubaccountToTerraform(account, d); err == nil {
-                    log.WithFields(
-                        log.Fields{
-                            "sid":                account.Sid,
-                            "parent_account_sid": config.AccountSID,
-                            "friendly_name":      friendlyName,
-                        },
-                    ).Debug("END client.Accounts.GetPage")
-                    return nil
-                } else {
-                    log.WithFields(
-                        log.Fields{
-                            "parent_account_sid": config.AccountSID,
-                            "sid":                account.Sid,
-                            "friendly_name":      friendlyName,
-                        },
-                    ).WithError(err).Error("ERROR mapTwilioSubaccountToTerraform")
-                    return fmt.Errorf("unable to map subaccount attributes for number with sid: %s\nerror: %s", d.Id(), err.Error())
-                }
-            }
-        }
-        return fmt.Errorf("unable to find subaccount with friendlyName: %s", friendlyName)
 	}
+
+	var found []*twilio.Account
+	for _, account := range accounts {
+		if account.FriendlyName == friendlyName {
+			found = append(found, account)
+		}
+	}
+
+	log.WithFields(
+		log.Fields{
+			"parent_account_sid": config.AccountSID,
+			"friendly_name":      friendlyName,
+			"match_count":        len(found),
+		},
+	).Debug("END client.Accounts.GetPageIterator")
+
+	if len(found) == 0 {
+		return fmt.Errorf("unable to find subaccount with friendlyName: %s", friendlyName)
+	}
+	if len(found) > 1 {
+		sids := make([]string, 0, len(found))
+		for _, account := range found {
+			sids = append(sids, account.Sid)
+		}
+		return ambiguousMatchError("subaccounts", "friendlyName: "+friendlyName, sids)
+	}
+
+	account := found[0]
+	d.SetId(account.Sid)
+	if err = mapTwilioSubaccountToTerraform(account, d, true); err != nil {
+		log.WithFields(
+			log.Fields{
+				"parent_account_sid": config.AccountSID,
+				"sid":                account.Sid,
+				"friendly_name":      friendlyName,
+			},
+		).WithError(err).Error("ERROR mapTwilioSubaccountToTerraform")
+		return fmt.Errorf("unable to map subaccount attributes for number with sid: %s\nerror: %s", d.Id(), err.Error())
+	}
+	return nil
 }
@@ -0,0 +1,189 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func dataTwilioSubaccounts() *schema.Resource {
+	return &schema.Resource{
+		Read: dataTwilioSubaccountsRead,
+
+		Schema: map[string]*schema.Schema{
+			"status": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"active",
+					"suspended",
+					"closed",
+				}, false),
+				Description: "Only return subaccounts with this status. Can be `active`, `suspended` or `closed`.",
+			},
+			"friendly_name_prefix": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"friendly_name_regex"},
+				Description:   "Only return subaccounts whose friendly name starts with this prefix.",
+			},
+			"friendly_name_regex": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ValidateFunc:  validation.ValidateRegexp,
+				ConflictsWith: []string{"friendly_name_prefix"},
+				Description:   "Only return subaccounts whose friendly name matches this regular expression.",
+			},
+			"created_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.ValidateRFC3339TimeString,
+				Description:  "Only return subaccounts created after this RFC 3339 timestamp, e.g. `2020-01-01T00:00:00Z`.",
+			},
+			"sids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The SIDs of the matching subaccounts.",
+			},
+			"subaccounts": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"friendly_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"parent_account_sid": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date_created": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"date_updated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+				Description: "The matching subaccounts, in the same order as `sids`. Auth tokens are left out.",
+			},
+		},
+	}
+}
+
+func dataTwilioSubaccountsRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER dataTwilioSubaccountsRead")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	ctx := context.TODO()
+
+	friendlyNamePrefix := d.Get("friendly_name_prefix").(string)
+	createdAfter := d.Get("created_after").(string)
+
+	var friendlyNameRegex *regexp.Regexp
+	if r := d.Get("friendly_name_regex").(string); r != "" {
+		var err error
+		if friendlyNameRegex, err = regexp.Compile(r); err != nil {
+			return fmt.Errorf("Invalid friendly_name_regex %q: %s", r, err)
+		}
+	}
+
+	var after time.Time
+	if createdAfter != "" {
+		var err error
+		if after, err = time.Parse(time.RFC3339, createdAfter); err != nil {
+			return fmt.Errorf("Invalid created_after %q: %s", createdAfter, err)
+		}
+	}
+
+	query := make(url.Values)
+	addIfNotEmpty(query, "Status", d.Get("status"))
+
+	log.WithFields(
+		log.Fields{
+			"parent_account_sid": config.AccountSID,
+		},
+	).Debug("START client.Accounts.GetPageIterator")
+
+	accounts, err := listAccounts(ctx, client, query)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"parent_account_sid": config.AccountSID,
+			},
+		).WithError(err).Error("ERROR client.Accounts.GetPageIterator")
+
+		return fmt.Errorf("Encountered an error when listing subaccounts: %s", err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"parent_account_sid": config.AccountSID,
+			"result_count":       len(accounts),
+		},
+	).Debug("END client.Accounts.GetPageIterator")
+
+	sids := make([]string, 0)
+	subaccounts := make([]map[string]interface{}, 0)
+	for _, account := range accounts {
+		// The list includes the parent account itself.
+		if account.Sid == client.AccountSid {
+			continue
+		}
+		if friendlyNamePrefix != "" && !strings.HasPrefix(account.FriendlyName, friendlyNamePrefix) {
+			continue
+		}
+		if friendlyNameRegex != nil && !friendlyNameRegex.MatchString(account.FriendlyName) {
+			continue
+		}
+		if createdAfter != "" && (!account.DateCreated.Valid || !account.DateCreated.Time.After(after)) {
+			continue
+		}
+
+		subaccount := map[string]interface{}{
+			"sid":                account.Sid,
+			"friendly_name":      account.FriendlyName,
+			"status":             string(account.Status),
+			"parent_account_sid": account.OwnerAccountSid,
+		}
+		if account.DateCreated.Valid {
+			subaccount["date_created"] = account.DateCreated.Time.Format("2006-01-02T15:04:05-07:00")
+		}
+		if account.DateUpdated.Valid {
+			subaccount["date_updated"] = account.DateUpdated.Time.Format("2006-01-02T15:04:05-07:00")
+		}
+		sids = append(sids, account.Sid)
+		subaccounts = append(subaccounts, subaccount)
+	}
+
+	filters := []string{d.Get("status").(string), friendlyNamePrefix, d.Get("friendly_name_regex").(string), createdAfter}
+	d.SetId(fmt.Sprintf("%s/%d", config.AccountSID, hashcode.String(strings.Join(filters, "/"))))
+
+	err = d.Set("sids", sids)
+	if err == nil {
+		err = d.Set("subaccounts", subaccounts)
+	}
+	return err
+}
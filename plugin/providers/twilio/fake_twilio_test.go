@@ -0,0 +1,66 @@
+package twilio
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+
+	"github.com/kevinburke/twilio-go"
+)
+
+// fakeTwilio serves canned responses keyed by "METHOD /path" and records every request it receives, along with the
+// form of the last request to each "METHOD /path". DELETE requests without a canned response succeed, anything else
+// without one is a 404.
+type fakeTwilio struct {
+	server    *httptest.Server
+	responses map[string]string
+	requests  []string
+	forms     map[string]url.Values
+}
+
+func newFakeTwilio(responses map[string]string) *fakeTwilio {
+	f := &fakeTwilio{responses: responses, forms: make(map[string]url.Values)}
+	f.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := r.Method + " " + r.URL.Path
+		f.requests = append(f.requests, request)
+		if err := r.ParseForm(); err == nil {
+			f.forms[request] = r.Form
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if body, ok := f.responses[request]; ok {
+			w.Write([]byte(body))
+		} else if r.Method == "DELETE" {
+			w.WriteHeader(http.StatusNoContent)
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"code": 20404, "message": "not found", "status": 404}`))
+		}
+	}))
+	return f
+}
+
+// meta returns a provider context whose clients all talk to the fake server as account AC123.
+func (f *fakeTwilio) meta() *TerraformTwilioContext {
+	client := twilio.NewClient("AC123", "token", f.server.Client())
+	client.Base = f.server.URL
+	client.Message.Base = f.server.URL
+
+	return &TerraformTwilioContext{client: client, configuration: Config{AccountSID: "AC123", AuthToken: "token"}}
+}
+
+// requestsMatching returns the recorded requests with the given method whose path contains part.
+func (f *fakeTwilio) requestsMatching(method string, part string) []string {
+	var matching []string
+	for _, request := range f.requests {
+		if strings.HasPrefix(request, method+" ") && strings.Contains(request, part) {
+			matching = append(matching, request)
+		}
+	}
+	return matching
+}
+
+func (f *fakeTwilio) Close() {
+	f.server.Close()
+}
@@ -0,0 +1,105 @@
+package twilio
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/kevinburke/twilio-go"
+)
+
+const incomingPhoneNumbersPathPart = "IncomingPhoneNumbers"
+
+// phoneNumberCapability carries the fax capability that twilio.NumberCapability is missing.
+type phoneNumberCapability struct {
+	MMS   bool `json:"mms"`
+	SMS   bool `json:"sms"`
+	Voice bool `json:"voice"`
+	Fax   bool `json:"fax"`
+}
+
+// incomingPhoneNumber extends twilio.IncomingPhoneNumber with the attributes the twilio-go model does not map.
+type incomingPhoneNumber struct {
+	twilio.IncomingPhoneNumber
+	AddressSid             string                 `json:"address_sid"`
+	IdentitySid            string                 `json:"identity_sid"`
+	BundleSid              string                 `json:"bundle_sid"`
+	EmergencyAddressStatus string                 `json:"emergency_address_status"`
+	Origin                 string                 `json:"origin"`
+	Status                 string                 `json:"status"`
+	VoiceReceiveMode       string                 `json:"voice_receive_mode"`
+	Capabilities           *phoneNumberCapability `json:"capabilities"`
+}
+
+type incomingPhoneNumberPage struct {
+	listPage
+	IncomingPhoneNumbers []*incomingPhoneNumber `json:"incoming_phone_numbers"`
+}
+
+// incomingPhoneNumbersPath returns the full path of the IncomingPhoneNumbers list owned by accountSid, which may be a
+// subaccount of the configured account. An empty accountSid refers to the configured account.
+func incomingPhoneNumbersPath(client *twilio.Client, accountSid string, sid string) string {
+	if accountSid == "" {
+		accountSid = client.AccountSid
+	}
+	path := []string{"", client.APIVersion, "Accounts", accountSid, incomingPhoneNumbersPathPart}
+	if sid != "" {
+		path = append(path, sid)
+	}
+	return strings.Join(path, "/") + ".json"
+}
+
+func getIncomingPhoneNumber(ctx context.Context, client *twilio.Client, accountSid string, sid string) (*incomingPhoneNumber, error) {
+	number := new(incomingPhoneNumber)
+	err := client.MakeRequest(ctx, "GET", incomingPhoneNumbersPath(client, accountSid, sid), nil, number)
+	return number, err
+}
+
+func createIncomingPhoneNumber(ctx context.Context, client *twilio.Client, accountSid string, data url.Values) (*incomingPhoneNumber, error) {
+	number := new(incomingPhoneNumber)
+	err := client.MakeRequest(ctx, "POST", incomingPhoneNumbersPath(client, accountSid, ""), data, number)
+	return number, err
+}
+
+func updateIncomingPhoneNumber(ctx context.Context, client *twilio.Client, accountSid string, sid string, data url.Values) (*incomingPhoneNumber, error) {
+	number := new(incomingPhoneNumber)
+	err := client.MakeRequest(ctx, "POST", incomingPhoneNumbersPath(client, accountSid, sid), data, number)
+	return number, err
+}
+
+func releaseIncomingPhoneNumber(ctx context.Context, client *twilio.Client, accountSid string, sid string) error {
+	err := client.MakeRequest(ctx, "DELETE", incomingPhoneNumbersPath(client, accountSid, sid), nil, nil)
+	if isNotFoundError(err) {
+		return nil
+	}
+	return err
+}
+
+// listIncomingPhoneNumbers returns every IncomingPhoneNumber owned by accountSid that matches data.
+func listIncomingPhoneNumbers(ctx context.Context, client *twilio.Client, accountSid string, data url.Values) ([]*incomingPhoneNumber, error) {
+	return listIncomingPhoneNumbersOfType(ctx, client, accountSid, "", data)
+}
+
+// listIncomingPhoneNumbersOfType returns every IncomingPhoneNumber of the given type (`Local`, `Mobile` or `TollFree`)
+// owned by accountSid that matches data. An empty numType lists numbers of every type.
+func listIncomingPhoneNumbersOfType(ctx context.Context, client *twilio.Client, accountSid string, numType string, data url.Values) ([]*incomingPhoneNumber, error) {
+	if data == nil {
+		data = make(url.Values)
+	}
+	data.Set("PageSize", "1000")
+
+	iter := newPageIterator(client, incomingPhoneNumbersPath(client, accountSid, numType), data)
+
+	numbers := make([]*incomingPhoneNumber, 0)
+	for {
+		page := new(incomingPhoneNumberPage)
+		if err := iter.Next(ctx, page); err == twilio.NoMoreResults {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		numbers = append(numbers, page.IncomingPhoneNumbers...)
+	}
+	return numbers, nil
+}
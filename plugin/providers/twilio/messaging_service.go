@@ -0,0 +1,39 @@
+package twilio
+
+import (
+	"context"
+	"net/url"
+
+	"github.com/kevinburke/twilio-go"
+)
+
+const messagingServicesPathPart = "Services"
+
+// messagingService extends twilio.Service with the attributes the twilio-go model does not map.
+type messagingService struct {
+	twilio.Service
+	Usecase                   string            `json:"usecase"`
+	ScanMessageContent        string            `json:"scan_message_content"`
+	UseInboundWebhookOnNumber bool              `json:"use_inbound_webhook_on_number"`
+	UsAppToPersonRegistered   bool              `json:"us_app_to_person_registered"`
+	URL                       string            `json:"url"`
+	Links                     map[string]string `json:"links"`
+}
+
+func getMessagingService(ctx context.Context, client *twilio.Client, sid string) (*messagingService, error) {
+	service := new(messagingService)
+	err := client.Message.GetResource(ctx, messagingServicesPathPart, sid, service)
+	return service, err
+}
+
+func createMessagingService(ctx context.Context, client *twilio.Client, data url.Values) (*messagingService, error) {
+	service := new(messagingService)
+	err := client.Message.CreateResource(ctx, messagingServicesPathPart, data, service)
+	return service, err
+}
+
+func updateMessagingService(ctx context.Context, client *twilio.Client, sid string, data url.Values) (*messagingService, error) {
+	service := new(messagingService)
+	err := client.Message.UpdateResource(ctx, messagingServicesPathPart, sid, data, service)
+	return service, err
+}
@@ -0,0 +1,133 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/kevinburke/twilio-go"
+)
+
+// messagingServiceSender is a member of one of a messaging service's sender pools: a phone number, a short code or an
+// alpha sender ID.
+type messagingServiceSender struct {
+	Sid          string            `json:"sid"`
+	AccountSid   string            `json:"account_sid"`
+	ServiceSid   string            `json:"service_sid"`
+	PhoneNumber  string            `json:"phone_number"`
+	ShortCode    string            `json:"short_code"`
+	AlphaSender  string            `json:"alpha_sender"`
+	CountryCode  string            `json:"country_code"`
+	Capabilities []string          `json:"capabilities"`
+	DateCreated  twilio.TwilioTime `json:"date_created"`
+	DateUpdated  twilio.TwilioTime `json:"date_updated"`
+}
+
+type messagingServiceSenderPage struct {
+	listPage
+	PhoneNumbers []*messagingServiceSender `json:"phone_numbers"`
+	ShortCodes   []*messagingServiceSender `json:"short_codes"`
+	AlphaSenders []*messagingServiceSender `json:"alpha_senders"`
+}
+
+// messagingServiceSenderKind describes one of the sender sub-collections of a messaging service.
+type messagingServiceSenderKind struct {
+	// pathPart is the name of the sub-collection under Services/{ServiceSid}.
+	pathPart string
+	// param is the create parameter identifying the sender.
+	param string
+	// sidPrefix is the prefix of the SIDs of the sub-collection's members.
+	sidPrefix string
+}
+
+var (
+	messagingServicePhoneNumberKind = &messagingServiceSenderKind{pathPart: "PhoneNumbers", param: "PhoneNumberSid", sidPrefix: "PN"}
+	messagingServiceShortCodeKind   = &messagingServiceSenderKind{pathPart: "ShortCodes", param: "ShortCodeSid", sidPrefix: "SC"}
+	messagingServiceAlphaSenderKind = &messagingServiceSenderKind{pathPart: "AlphaSenders", param: "AlphaSender", sidPrefix: "AI"}
+	messagingServiceSenderKinds     = []*messagingServiceSenderKind{messagingServicePhoneNumberKind, messagingServiceShortCodeKind, messagingServiceAlphaSenderKind}
+)
+
+// messagingServiceSenderKindOf returns the kind of sender identified by sid, based on its prefix.
+func messagingServiceSenderKindOf(sid string) (*messagingServiceSenderKind, error) {
+	for _, kind := range messagingServiceSenderKinds {
+		if strings.HasPrefix(sid, kind.sidPrefix) {
+			return kind, nil
+		}
+	}
+	return nil, fmt.Errorf("%s is not the SID of a phone number (PN...), short code (SC...) or alpha sender (AI...)", sid)
+}
+
+func (k *messagingServiceSenderKind) path(serviceSid string) string {
+	return strings.Join([]string{"Services", serviceSid, k.pathPart}, "/")
+}
+
+func fromServicePhoneNumber(pn *twilio.ServicePhoneNumber) *messagingServiceSender {
+	return &messagingServiceSender{
+		Sid:         pn.Sid,
+		AccountSid:  pn.AccountSid,
+		ServiceSid:  pn.ServiceSid,
+		PhoneNumber: string(pn.PhoneNumber),
+		CountryCode: pn.CountryCode,
+		DateCreated: pn.DateCreated,
+		DateUpdated: pn.DateUpdated,
+	}
+}
+
+// addMessagingServiceSender adds the sender identified by value (a PN or SC SID, or the alpha sender ID itself) to the
+// messaging service's pool of the given kind.
+func addMessagingServiceSender(ctx context.Context, client *twilio.Client, serviceSid string, kind *messagingServiceSenderKind, value string) (*messagingServiceSender, error) {
+	if kind == messagingServicePhoneNumberKind {
+		pn, err := client.Message.Services.CreatePhoneNumber(ctx, serviceSid, value)
+		if err != nil {
+			return nil, err
+		}
+		return fromServicePhoneNumber(pn), nil
+	}
+
+	sender := new(messagingServiceSender)
+	err := client.Message.CreateResource(ctx, kind.path(serviceSid), url.Values{kind.param: []string{value}}, sender)
+	return sender, err
+}
+
+func getMessagingServiceSender(ctx context.Context, client *twilio.Client, serviceSid string, kind *messagingServiceSenderKind, sid string) (*messagingServiceSender, error) {
+	if kind == messagingServicePhoneNumberKind {
+		pn, err := client.Message.Services.GetPhoneNumber(ctx, serviceSid, sid)
+		if err != nil {
+			return nil, err
+		}
+		return fromServicePhoneNumber(pn), nil
+	}
+
+	sender := new(messagingServiceSender)
+	err := client.Message.GetResource(ctx, kind.path(serviceSid), sid, sender)
+	return sender, err
+}
+
+// listMessagingServiceSenders returns every sender in the messaging service's pool of the given kind.
+func listMessagingServiceSenders(ctx context.Context, client *twilio.Client, serviceSid string, kind *messagingServiceSenderKind) ([]*messagingServiceSender, error) {
+	iter := newPageIterator(client.Message, kind.path(serviceSid), url.Values{"PageSize": []string{"1000"}})
+
+	senders := make([]*messagingServiceSender, 0)
+	for {
+		page := new(messagingServiceSenderPage)
+		if err := iter.Next(ctx, page); err == twilio.NoMoreResults {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		senders = append(senders, page.PhoneNumbers...)
+		senders = append(senders, page.ShortCodes...)
+		senders = append(senders, page.AlphaSenders...)
+	}
+	return senders, nil
+}
+
+// removeMessagingServiceSender removes a sender from a messaging service. Senders that are already gone are ignored.
+func removeMessagingServiceSender(ctx context.Context, client *twilio.Client, serviceSid string, kind *messagingServiceSenderKind, sid string) error {
+	if kind == messagingServicePhoneNumberKind {
+		return client.Message.Services.DeletePhoneNumber(ctx, serviceSid, sid)
+	}
+	return client.Message.DeleteResource(ctx, kind.path(serviceSid), sid)
+}
@@ -0,0 +1,75 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/kevinburke/twilio-go"
+)
+
+// listPage is embedded by every list response so that pageIterator can follow it. API v2010 lists link to the next
+// page through next_page_uri, while the newer APIs (e.g. Messaging v1) use meta.next_page_url.
+type listPage struct {
+	NextPageURI string `json:"next_page_uri"`
+	Meta        struct {
+		NextPageURL string `json:"next_page_url"`
+	} `json:"meta"`
+}
+
+func (p *listPage) nextPage() string {
+	if p.NextPageURI != "" {
+		return p.NextPageURI
+	}
+	return p.Meta.NextPageURL
+}
+
+// pager is implemented by any list response embedding listPage.
+type pager interface {
+	nextPage() string
+}
+
+// pageIterator walks every page of a Twilio list resource.
+type pageIterator struct {
+	client   *twilio.Client
+	pathPart string
+	data     url.Values
+	started  bool
+	next     string
+}
+
+// newPageIterator returns a pageIterator listing pathPart, which is either relative to the client's API version or a
+// full path, with the query in data.
+func newPageIterator(client *twilio.Client, pathPart string, data url.Values) *pageIterator {
+	return &pageIterator{
+		client:   client,
+		pathPart: pathPart,
+		data:     data,
+	}
+}
+
+// Next decodes the next page into page. Once every page has been read, twilio.NoMoreResults is returned.
+func (it *pageIterator) Next(ctx context.Context, page pager) error {
+	var err error
+	switch {
+	case !it.started:
+		err = it.client.ListResource(ctx, it.pathPart, it.data, page)
+	case it.next != "":
+		err = it.client.GetNextPage(ctx, it.next, page)
+	default:
+		return twilio.NoMoreResults
+	}
+	if err != nil {
+		return err
+	}
+
+	it.started = true
+	it.next = page.nextPage()
+	return nil
+}
+
+// ambiguousMatchError reports that a lookup expected to find a single resource matched several.
+func ambiguousMatchError(kind string, query string, sids []string) error {
+	return fmt.Errorf("%d %s match %s (%s), refine the query so that only one matches", len(sids), kind, query, strings.Join(sids, ", "))
+}
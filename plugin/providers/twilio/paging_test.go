@@ -0,0 +1,111 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+
+	"github.com/kevinburke/twilio-go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+type testPage struct {
+	listPage
+	Items []string `json:"items"`
+}
+
+var _ = Describe("pageIterator", func() {
+	var server *httptest.Server
+	var client *twilio.Client
+	var requests []string
+	var pages map[string]string
+
+	BeforeEach(func() {
+		requests = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r.URL.RequestURI())
+			body, ok := pages[r.URL.RequestURI()]
+			if !ok {
+				w.WriteHeader(http.StatusNotFound)
+				body = `{"code": 20404, "message": "not found", "status": 404}`
+			}
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(body))
+		}))
+
+		client = twilio.NewMonitorClient("AC123", "token", server.Client())
+		client.Base = server.URL
+		client.APIVersion = "v1"
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	collect := func(it *pageIterator) ([]string, error) {
+		var items []string
+		for {
+			page := new(testPage)
+			if err := it.Next(context.TODO(), page); err == twilio.NoMoreResults {
+				return items, nil
+			} else if err != nil {
+				return items, err
+			}
+			items = append(items, page.Items...)
+		}
+	}
+
+	It("follows meta.next_page_url across pages", func() {
+		pages = map[string]string{
+			"/v1/Things?PageSize=2": fmt.Sprintf(`{"items": ["a", "b"], "meta": {"next_page_url": "%s/v1/Things?Page=1"}}`, server.URL),
+			"/v1/Things?Page=1":     fmt.Sprintf(`{"items": ["c", "d"], "meta": {"next_page_url": "%s/v1/Things?Page=2"}}`, server.URL),
+			"/v1/Things?Page=2":     `{"items": ["e"], "meta": {"next_page_url": null}}`,
+		}
+
+		items, err := collect(newPageIterator(client, "Things", url.Values{"PageSize": {"2"}}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(items).To(Equal([]string{"a", "b", "c", "d", "e"}))
+		Expect(requests).To(HaveLen(3))
+	})
+
+	It("follows next_page_uri across pages", func() {
+		pages = map[string]string{
+			"/v1/Things?PageSize=2": `{"items": ["a", "b"], "next_page_uri": "/v1/Things?Page=1"}`,
+			"/v1/Things?Page=1":     `{"items": ["c"], "next_page_uri": null}`,
+		}
+
+		items, err := collect(newPageIterator(client, "Things", url.Values{"PageSize": {"2"}}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(items).To(Equal([]string{"a", "b", "c"}))
+	})
+
+	It("stops after a single page without a next page", func() {
+		pages = map[string]string{
+			"/v1/Things?PageSize=2": `{"items": ["a"], "meta": {}}`,
+		}
+
+		items, err := collect(newPageIterator(client, "Things", url.Values{"PageSize": {"2"}}))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(items).To(Equal([]string{"a"}))
+		Expect(requests).To(HaveLen(1))
+	})
+
+	It("returns errors from any page", func() {
+		pages = map[string]string{
+			"/v1/Things?PageSize=2": `{"items": ["a"], "next_page_uri": "/v1/Things?Page=1"}`,
+		}
+
+		_, err := collect(newPageIterator(client, "Things", url.Values{"PageSize": {"2"}}))
+		Expect(isNotFoundError(err)).To(BeTrue())
+	})
+})
+
+var _ = Describe("ambiguousMatchError", func() {
+	It("lists every matching SID", func() {
+		err := ambiguousMatchError("phone numbers", "+15551234567", []string{"PN1", "PN2"})
+		Expect(err).To(MatchError("2 phone numbers match +15551234567 (PN1, PN2), refine the query so that only one matches"))
+	})
+})
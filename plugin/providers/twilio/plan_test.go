@@ -0,0 +1,30 @@
+package twilio
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// planResource plans config against an existing instance of r holding state, or against a new instance when id is
+// empty, running the resource's CustomizeDiff without a provider.
+func planResource(r *schema.Resource, id string, state map[string]interface{}, config map[string]interface{}) (*terraform.InstanceDiff, error) {
+	var s *terraform.InstanceState
+	if id != "" {
+		d := r.Data(nil)
+		for key, value := range state {
+			if err := d.Set(key, value); err != nil {
+				return nil, err
+			}
+		}
+		d.SetId(id)
+		s = d.State()
+	}
+	return r.Diff(s, terraform.NewResourceConfigRaw(config), nil)
+}
+
+// validateResource validates config against r's schema the way Terraform does before planning, which is where
+// `ConflictsWith` is checked.
+func validateResource(r *schema.Resource, config map[string]interface{}) []error {
+	_, errs := r.Validate(terraform.NewResourceConfigRaw(config))
+	return errs
+}
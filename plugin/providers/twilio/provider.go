@@ -1,8 +1,14 @@
 package twilio
 
 import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
+	"github.com/kevinburke/rest"
 )
 
 var descriptions map[string]string
@@ -42,19 +48,32 @@ func providerSchema() map[string]*schema.Schema {
 // List of supported resources and their configuration fields.
 func providerResources() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-		"twilio_phone_number": resourceTwilioPhoneNumber(),
-		"twilio_messaging_service": resourceTwilioMessagingService(),
-		"twilio_subaccount":   resourceTwilioSubaccount(),
-		"twilio_api_key":      resourceTwilioApiKey(),
+		"twilio_phone_number":                    resourceTwilioPhoneNumber(),
+		"twilio_phone_number_pool":               resourceTwilioPhoneNumberPool(),
+		"twilio_phone_number_configuration":      resourceTwilioPhoneNumberConfiguration(),
+		"twilio_messaging_service":               resourceTwilioMessagingService(),
+		"twilio_messaging_service_sender":        resourceTwilioMessagingServiceSender(),
+		"twilio_messaging_service_short_code":    resourceTwilioMessagingServiceShortCode(),
+		"twilio_messaging_service_alpha_sender":  resourceTwilioMessagingServiceAlphaSender(),
+		"twilio_messaging_service_a2p_campaign":  resourceTwilioMessagingServiceA2PCampaign(),
+		"twilio_a2p_brand_registration":          resourceTwilioA2PBrandRegistration(),
+		"twilio_tollfree_verification":           resourceTwilioTollfreeVerification(),
+		"twilio_subaccount":                      resourceTwilioSubaccount(),
+		"twilio_subaccount_secondary_auth_token": resourceTwilioSubaccountSecondaryAuthToken(),
+		"twilio_api_key":                         resourceTwilioApiKey(),
 	}
 }
 
 // List of supported data sources and their configuration fields.
 func providerDataSourcesMap() map[string]*schema.Resource {
 	return map[string]*schema.Resource{
-	    "twilio_messaging_service": dataTwilioMessagingService(),
-		"twilio_phone_number": dataTwilioPhoneNumber(),
-		"twilio_subaccount":   dataTwilioSubaccount(),
+		"twilio_available_phone_numbers": dataTwilioAvailablePhoneNumbers(),
+		"twilio_messaging_service":       dataTwilioMessagingService(),
+		"twilio_phone_number":            dataTwilioPhoneNumber(),
+		"twilio_phone_numbers":           dataTwilioPhoneNumbers(),
+		"twilio_short_code":              dataTwilioShortCode(),
+		"twilio_subaccount":              dataTwilioSubaccount(),
+		"twilio_subaccounts":             dataTwilioSubaccounts(),
 	}
 }
 
@@ -75,6 +94,8 @@ func makeComputed(s map[string]*schema.Schema) map[string]*schema.Schema {
 		p.MaxItems = 0
 		p.MinItems = 0
 		p.ValidateFunc = nil
+		p.ConflictsWith = nil
+		p.DiffSuppressFunc = nil
 		p.DefaultFunc = nil
 		p.Default = nil
 		if resource, ok := p.Elem.(*schema.Resource); ok {
@@ -83,3 +104,48 @@ func makeComputed(s map[string]*schema.Schema) map[string]*schema.Schema {
 	}
 	return s
 }
+
+// isNotFoundError reports whether err is the Twilio API's response for a resource that does not exist.
+func isNotFoundError(err error) bool {
+	rerr, ok := err.(*rest.Error)
+	return ok && rerr.Status == http.StatusNotFound
+}
+
+// validateURL is a schema.SchemaValidateFunc accepting absolute http and https URLs.
+func validateURL(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+	if value == "" {
+		return
+	}
+
+	u, err := url.Parse(value)
+	if err != nil {
+		errors = append(errors, fmt.Errorf("expected %s to be a valid URL, got %q: %s", k, value, err))
+	} else if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		errors = append(errors, fmt.Errorf("expected %s to be an absolute http or https URL, got %q", k, value))
+	}
+	return
+}
+
+// validateDuration accepts an empty string or a duration understood by time.ParseDuration, e.g. `10m` or `1h30m`.
+func validateDuration(v interface{}, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+	if value == "" {
+		return
+	}
+
+	if d, err := time.ParseDuration(value); err != nil {
+		errors = append(errors, fmt.Errorf("expected %s to be a duration such as 10m, got %q: %s", k, value, err))
+	} else if d < 0 {
+		errors = append(errors, fmt.Errorf("expected %s to be a positive duration, got %q", k, value))
+	}
+	return
+}
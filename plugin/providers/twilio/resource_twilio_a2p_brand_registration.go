@@ -0,0 +1,411 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/kevinburke/twilio-go"
+	"github.com/spf13/cast"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const a2pBrandRegistrationsPathPart = "a2p/BrandRegistrations"
+
+// a2pBrandRegistration is a US A2P 10DLC brand registered through the Messaging v1 API.
+type a2pBrandRegistration struct {
+	Sid                      string            `json:"sid"`
+	AccountSid               string            `json:"account_sid"`
+	CustomerProfileBundleSid string            `json:"customer_profile_bundle_sid"`
+	A2PProfileBundleSid      string            `json:"a2p_profile_bundle_sid"`
+	BrandType                string            `json:"brand_type"`
+	Status                   string            `json:"status"`
+	TcrID                    string            `json:"tcr_id"`
+	FailureReason            string            `json:"failure_reason"`
+	BrandScore               int               `json:"brand_score"`
+	BrandFeedback            []string          `json:"brand_feedback"`
+	IdentityStatus           string            `json:"identity_status"`
+	Russell3000              bool              `json:"russell_3000"`
+	GovernmentEntity         bool              `json:"government_entity"`
+	TaxExemptStatus          string            `json:"tax_exempt_status"`
+	SkipAutomaticSecVet      bool              `json:"skip_automatic_sec_vet"`
+	Mock                     bool              `json:"mock"`
+	URL                      string            `json:"url"`
+	DateCreated              twilio.TwilioTime `json:"date_created"`
+	DateUpdated              twilio.TwilioTime `json:"date_updated"`
+}
+
+// a2pBrandRegistrationPendingStatuses are the statuses of a brand whose vetting is not finished.
+var a2pBrandRegistrationPendingStatuses = []string{"PENDING", "IN_REVIEW"}
+
+func resourceTwilioA2PBrandRegistration() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTwilioA2PBrandRegistrationCreate,
+		Read:   resourceTwilioA2PBrandRegistrationRead,
+		Update: resourceTwilioA2PBrandRegistrationUpdate,
+		Delete: resourceTwilioA2PBrandRegistrationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTwilioA2PBrandRegistrationImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"customer_profile_bundle_sid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "SID of the approved Secondary Customer Profile bundle (BU...) of the business registering the brand.",
+			},
+			"a2p_profile_bundle_sid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "SID of the approved A2P Messaging Profile bundle (BU...) of the brand.",
+			},
+			"brand_type": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  "STANDARD",
+				ValidateFunc: validation.StringInSlice([]string{
+					"STANDARD",
+					"SOLE_PROPRIETOR",
+				}, false),
+				Description: "The type of brand. Can be `STANDARD` or `SOLE_PROPRIETOR`, defaults to `STANDARD`.",
+			},
+			"skip_automatic_sec_vet": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "If `true`, the automatic secondary vetting of the brand is skipped. Defaults to `false`.",
+			},
+			"mock": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "If `true`, a mock brand is registered for testing; it is never sent to The Campaign Registry. Defaults to `false`.",
+			},
+			"wait_for_approval": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If `true`, creating or resubmitting the brand waits until vetting completes, failing once the create (or, when resubmitting, update) timeout elapses. Vetting can take days, so raise the timeouts accordingly. A brand that is not approved is reported through `status` and `failure_reason` rather than failing the apply. Defaults to `false`.",
+			},
+			"resubmission_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Changing this value resubmits a `FAILED` brand for vetting, e.g. after fixing its customer profile. It has no effect on brands in any other status.",
+			},
+			"sid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier (BN...) of this brand registration.",
+			},
+			"account_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The registration status of the brand, e.g. `PENDING`, `IN_REVIEW`, `APPROVED` or `FAILED`.",
+			},
+			"tcr_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The brand's ID in The Campaign Registry.",
+			},
+			"failure_reason": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Why the registration failed, if it did.",
+			},
+			"brand_feedback": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Feedback from vetting on how to improve the brand score, e.g. `TAX_ID` or `STOCK_SYMBOL`.",
+			},
+			"brand_score": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"identity_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"russell_3000": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"government_entity": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"tax_exempt_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getA2PBrandRegistration(ctx context.Context, client *twilio.Client, sid string) (*a2pBrandRegistration, error) {
+	brand := new(a2pBrandRegistration)
+	err := client.Message.GetResource(ctx, a2pBrandRegistrationsPathPart, sid, brand)
+	return brand, err
+}
+
+func mapTwilioA2PBrandRegistrationToTerraform(brand *a2pBrandRegistration, d *schema.ResourceData) error {
+	err := d.Set("sid", brand.Sid)
+	if err == nil {
+		err = d.Set("account_sid", brand.AccountSid)
+	}
+	if err == nil {
+		err = d.Set("customer_profile_bundle_sid", brand.CustomerProfileBundleSid)
+	}
+	if err == nil {
+		err = d.Set("a2p_profile_bundle_sid", brand.A2PProfileBundleSid)
+	}
+	if err == nil && brand.BrandType != "" {
+		err = d.Set("brand_type", brand.BrandType)
+	}
+	if err == nil {
+		err = d.Set("skip_automatic_sec_vet", brand.SkipAutomaticSecVet)
+	}
+	if err == nil {
+		err = d.Set("mock", brand.Mock)
+	}
+	if err == nil {
+		err = d.Set("status", brand.Status)
+	}
+	if err == nil {
+		err = d.Set("tcr_id", brand.TcrID)
+	}
+	if err == nil {
+		err = d.Set("failure_reason", brand.FailureReason)
+	}
+	if err == nil {
+		err = d.Set("brand_feedback", brand.BrandFeedback)
+	}
+	if err == nil {
+		err = d.Set("brand_score", brand.BrandScore)
+	}
+	if err == nil {
+		err = d.Set("identity_status", brand.IdentityStatus)
+	}
+	if err == nil {
+		err = d.Set("russell_3000", brand.Russell3000)
+	}
+	if err == nil {
+		err = d.Set("government_entity", brand.GovernmentEntity)
+	}
+	if err == nil {
+		err = d.Set("tax_exempt_status", brand.TaxExemptStatus)
+	}
+	if err == nil {
+		err = d.Set("url", brand.URL)
+	}
+	if err == nil && brand.DateCreated.Valid {
+		err = d.Set("date_created", brand.DateCreated.Time.Format("2006-01-02T15:04:05-07:00"))
+	}
+	if err == nil && brand.DateUpdated.Valid {
+		err = d.Set("date_updated", brand.DateUpdated.Time.Format("2006-01-02T15:04:05-07:00"))
+	}
+	return err
+}
+
+// waitForA2PBrandRegistration polls the brand in the resource ID until vetting completes, mapping it on every poll. A
+// brand that is not approved is left to `status` and `failure_reason`.
+func waitForA2PBrandRegistration(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	client := meta.(*TerraformTwilioContext).client
+	ctx := context.TODO()
+	sid := d.Id()
+
+	return waitForApproval(fmt.Sprintf("A2P brand registration %s", sid), timeout, a2pBrandRegistrationPendingStatuses, "APPROVED", func() (string, error) {
+		brand, err := getA2PBrandRegistration(ctx, client, sid)
+		if err != nil {
+			return "", fmt.Errorf("Encountered an error when getting A2P brand registration SID %s: %s", sid, err)
+		}
+		if err := mapTwilioA2PBrandRegistrationToTerraform(brand, d); err != nil {
+			return "", fmt.Errorf("Encountered an error while mapping Twilio API result to terraform: %s", err)
+		}
+		return brand.Status, nil
+	})
+}
+
+func resourceTwilioA2PBrandRegistrationCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioA2PBrandRegistrationCreate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	params := make(url.Values)
+	addIfNotEmpty(params, "CustomerProfileBundleSid", d.Get("customer_profile_bundle_sid"))
+	addIfNotEmpty(params, "A2PProfileBundleSid", d.Get("a2p_profile_bundle_sid"))
+	addIfNotEmpty(params, "BrandType", d.Get("brand_type"))
+	params.Set("SkipAutomaticSecVet", cast.ToString(d.Get("skip_automatic_sec_vet")))
+	params.Set("Mock", cast.ToString(d.Get("mock")))
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+		},
+	).Debug("START client.Message.BrandRegistrations.Create")
+
+	brand := new(a2pBrandRegistration)
+	if err := client.Message.CreateResource(context.TODO(), a2pBrandRegistrationsPathPart, params, brand); err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+			},
+		).WithError(err).Error("ERROR client.Message.BrandRegistrations.Create")
+
+		return fmt.Errorf("Encountered an error when registering A2P brand: %s", err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"brand_sid":   brand.Sid,
+		},
+	).Debug("END client.Message.BrandRegistrations.Create")
+
+	d.SetId(brand.Sid)
+
+	if err := mapTwilioA2PBrandRegistrationToTerraform(brand, d); err != nil {
+		return fmt.Errorf("Encountered error while reading result for A2P brand registration SID %s and mapping it to TF: %s", brand.Sid, err)
+	}
+
+	if d.Get("wait_for_approval").(bool) {
+		return waitForA2PBrandRegistration(d, meta, d.Timeout(schema.TimeoutCreate))
+	}
+	return nil
+}
+
+func resourceTwilioA2PBrandRegistrationRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioA2PBrandRegistrationRead")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"brand_sid":   sid,
+		},
+	).Debug("START client.Message.BrandRegistrations.Get")
+
+	brand, err := getA2PBrandRegistration(context.TODO(), client, sid)
+	if isNotFoundError(err) {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("Encountered an error when getting A2P brand registration SID %s: %s", sid, err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"brand_sid":   sid,
+			"status":      brand.Status,
+		},
+	).Debug("END client.Message.BrandRegistrations.Get")
+
+	if err := mapTwilioA2PBrandRegistrationToTerraform(brand, d); err != nil {
+		return fmt.Errorf("Encountered an error while mapping Twilio API result to terraform: %s", err)
+	}
+	return nil
+}
+
+// resourceTwilioA2PBrandRegistrationUpdate resubmits a failed brand for vetting when `resubmission_trigger` changes.
+// Every other updatable attribute only lives in state.
+func resourceTwilioA2PBrandRegistrationUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioA2PBrandRegistrationUpdate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	sid := d.Id()
+
+	if !d.HasChange("resubmission_trigger") || !strings.EqualFold(d.Get("status").(string), "FAILED") {
+		return nil
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"brand_sid":   sid,
+		},
+	).Debug("START client.Message.BrandRegistrations.Update")
+
+	brand := new(a2pBrandRegistration)
+	if err := client.Message.UpdateResource(context.TODO(), a2pBrandRegistrationsPathPart, sid, nil, brand); err != nil {
+		return fmt.Errorf("Failed to resubmit A2P brand registration SID %s: %s", sid, err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"brand_sid":   sid,
+		},
+	).Debug("END client.Message.BrandRegistrations.Update")
+
+	if err := mapTwilioA2PBrandRegistrationToTerraform(brand, d); err != nil {
+		return fmt.Errorf("Encountered an error while mapping Twilio API result to terraform: %s", err)
+	}
+
+	if d.Get("wait_for_approval").(bool) {
+		return waitForA2PBrandRegistration(d, meta, d.Timeout(schema.TimeoutUpdate))
+	}
+	return nil
+}
+
+// resourceTwilioA2PBrandRegistrationDelete only removes the brand from state: Twilio does not allow brand registrations
+// to be deleted.
+func resourceTwilioA2PBrandRegistrationDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioA2PBrandRegistrationDelete")
+
+	config := meta.(*TerraformTwilioContext).configuration
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"brand_sid":   d.Id(),
+		},
+	).Warn("A2P brand registrations cannot be deleted, removing it from state only")
+
+	return nil
+}
+
+func resourceTwilioA2PBrandRegistrationImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// Match the schema default so that the first plan after import is empty
+	if err := d.Set("wait_for_approval", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -2,15 +2,20 @@ package twilio
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/kevinburke/twilio-go"
 
 	log "github.com/sirupsen/logrus"
 )
 
+// apiKeyMaxGracePeriod is the longest `grace_period` accepted, and the default delete timeout that caps it.
+const apiKeyMaxGracePeriod = 30 * time.Minute
+
 func resourceTwilioApiKey() *schema.Resource {
 	return &schema.Resource{
 		Create: resourceTwilioApiKeyCreate,
@@ -20,33 +25,75 @@ func resourceTwilioApiKey() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		Timeouts: &schema.ResourceTimeout{
+			Delete: schema.DefaultTimeout(apiKeyMaxGracePeriod),
+		},
+		CustomizeDiff: rotateApiKeyOnSchedule,
 
 		Schema: map[string]*schema.Schema{
 			"sid": {
-                Type:     schema.TypeString,
-                Computed: true,
-            },
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"friendly_name": {
-                Type:     schema.TypeString,
-                Optional: true,
-            },
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "A name for the key. Twilio generates one if it is not set. Changing it renames the key in place.",
+			},
+			"rotation_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Number of days after which the key is replaced. The replacement is planned by the first plan after `rotate_after`.",
+			},
+			"keepers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that replace the key whenever they change.",
+			},
+			"grace_period": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateApiKeyGracePeriod,
+				Description:  "How long a replaced key stays valid before it is deleted, e.g. `10m`, at most `30m`. Destroy waits for this long, but never longer than the delete timeout. Requires `lifecycle { create_before_destroy = true }`: otherwise the old key is destroyed before its replacement exists and the wait only delays the apply.",
+			},
+			"rotate_after": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "When the key is due to be replaced, if `rotation_days` is set.",
+			},
 			"secret": {
-                Type:      schema.TypeString,
-                Computed:  true,
-                Sensitive: true,
-            },
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
 			"date_created": {
-                Type:     schema.TypeString,
-                Computed: true,
-            },
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"date_updated": {
-                Type:     schema.TypeString,
-                Computed: true,
-            },
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
 
+// validateApiKeyGracePeriod accepts durations of at most apiKeyMaxGracePeriod.
+func validateApiKeyGracePeriod(v interface{}, k string) (ws []string, errors []error) {
+	if ws, errors = validateDuration(v, k); len(errors) > 0 {
+		return
+	}
+
+	if d, err := time.ParseDuration(v.(string)); err == nil && d > apiKeyMaxGracePeriod {
+		errors = append(errors, fmt.Errorf("expected %s to be at most %s, got %q", k, apiKeyMaxGracePeriod, v))
+	}
+	return
+}
+
 func flattenKeyForCreate(d *schema.ResourceData) url.Values {
 	v := make(url.Values)
 
@@ -74,11 +121,12 @@ func resourceTwilioApiKeyCreate(d *schema.ResourceData, meta interface{}) error
 	}
 
 	d.SetId(createResult.Sid)
-	d.Set("sid", createResult.Sid)
-	d.Set("secret", createResult.Secret)
-	d.Set("friendly_name", createResult.FriendlyName) // In the event that the name wasn't specified, Twilio generates one for you
-	d.Set("date_created", createResult.DateCreated)
-	d.Set("date_updated", createResult.DateUpdated)
+	if err = d.Set("secret", createResult.Secret); err == nil {
+		err = mapTwilioApiKeyToTerraform(createResult, d)
+	}
+	if err != nil {
+		return fmt.Errorf("Encountered error while mapping key SID %s to TF: %s", createResult.Sid, err)
+	}
 
 	log.Debug("END client.Keys.Create")
 
@@ -96,24 +144,112 @@ func resourceTwilioApiKeyRead(d *schema.ResourceData, meta interface{}) error {
 	log.Debug("START client.Keys.Get")
 
 	key, err := client.Keys.Get(context, sid)
+	if isNotFoundError(err) {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("Failed to refresh key: %s", err.Error())
+	}
+
+	log.Debug("END client.Keys.Get")
 
-	d.Set("sid", key.Sid)
 	// Not updating the secret as Twilio only returns it on creation, not after
-	d.Set("friendly_name", key.FriendlyName) // In the event that the name wasn't specified, Twilio generates one for you
-	d.Set("date_created", key.DateCreated)
-	d.Set("date_updated", key.DateUpdated)
+	return mapTwilioApiKeyToTerraform(key, d)
+}
 
-	log.Debug("END client.Keys.Get")
+func mapTwilioApiKeyToTerraform(key *twilio.Key, d *schema.ResourceData) error {
+	err := d.Set("sid", key.Sid)
+	if err == nil {
+		// In the event that the name wasn't specified, Twilio generates one for you
+		err = d.Set("friendly_name", key.FriendlyName)
+	}
+	if err == nil && key.DateCreated.Valid {
+		err = d.Set("date_created", key.DateCreated.Time.Format("2006-01-02T15:04:05-07:00"))
+	}
+	if err == nil && key.DateUpdated.Valid {
+		err = d.Set("date_updated", key.DateUpdated.Time.Format("2006-01-02T15:04:05-07:00"))
+	}
+	if err == nil {
+		rotateAfter := ""
+		if days := d.Get("rotation_days").(int); days > 0 && key.DateCreated.Valid {
+			rotateAfter = key.DateCreated.Time.AddDate(0, 0, days).Format("2006-01-02T15:04:05-07:00")
+		}
+		err = d.Set("rotate_after", rotateAfter)
+	}
+	return err
+}
+
+// rotateApiKeyOnSchedule replaces the key once it is older than `rotation_days`, and keeps `rotate_after` in line with
+// changes to `rotation_days` otherwise.
+func rotateApiKeyOnSchedule(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
 
+	created, err := time.Parse("2006-01-02T15:04:05-07:00", d.Get("date_created").(string))
 	if err != nil {
-		return fmt.Errorf("Failed to refresh key: %s", err.Error())
+		return nil
+	}
+
+	rotateAfter := ""
+	if days := d.Get("rotation_days").(int); days > 0 {
+		due := created.AddDate(0, 0, days)
+		if time.Now().After(due) {
+			if d.HasChange("rotation_days") {
+				return d.ForceNew("rotation_days")
+			}
+			// ForceNew needs rotate_after to change, which SetNewComputed alone does not do when it was empty
+			if old, _ := d.GetChange("rotate_after"); old.(string) == "" {
+				if err := d.SetNew("rotate_after", due.Format("2006-01-02T15:04:05-07:00")); err != nil {
+					return err
+				}
+			} else if err := d.SetNewComputed("rotate_after"); err != nil {
+				return err
+			}
+			return d.ForceNew("rotate_after")
+		}
+		rotateAfter = due.Format("2006-01-02T15:04:05-07:00")
 	}
 
+	if d.HasChange("rotation_days") {
+		return d.SetNew("rotate_after", rotateAfter)
+	}
 	return nil
 }
 
+func flattenKeyForUpdate(d *schema.ResourceData) url.Values {
+	v := make(url.Values)
+
+	v.Add("FriendlyName", d.Get("friendly_name").(string))
+
+	return v
+}
+
+// resourceTwilioApiKeyUpdate renames the key. The friendly name is the only attribute of a key that can be changed.
 func resourceTwilioApiKeyUpdate(d *schema.ResourceData, meta interface{}) error {
-	return errors.New("Not implemented")
+	log.Debug("ENTER resourceTwilioApiKeyUpdate")
+
+	client := meta.(*TerraformTwilioContext).client
+	context := context.TODO()
+
+	sid := d.Id()
+
+	if !d.HasChange("friendly_name") {
+		return nil
+	}
+
+	log.Debug("START client.Keys.Update")
+
+	key, err := client.Keys.Update(context, sid, flattenKeyForUpdate(d))
+	if err != nil {
+		log.WithError(err).Error("client.Keys.Update failed")
+
+		return fmt.Errorf("Failed to update key: %s", err.Error())
+	}
+
+	log.Debug("END client.Keys.Update")
+
+	return mapTwilioApiKeyToTerraform(key, d)
 }
 
 func resourceTwilioApiKeyDelete(d *schema.ResourceData, meta interface{}) error {
@@ -124,11 +260,27 @@ func resourceTwilioApiKeyDelete(d *schema.ResourceData, meta interface{}) error
 
 	sid := d.Id()
 
+	if gracePeriod := d.Get("grace_period").(string); gracePeriod != "" {
+		wait, _ := time.ParseDuration(gracePeriod)
+		if timeout := d.Timeout(schema.TimeoutDelete); wait > timeout {
+			wait = timeout
+		}
+
+		log.WithFields(
+			log.Fields{
+				"key_sid":      sid,
+				"grace_period": wait.String(),
+			},
+		).Info("Waiting for the grace period to end before deleting the key")
+
+		time.Sleep(wait)
+	}
+
 	log.Debug("START client.Keys.Delete")
 
 	err := client.Keys.Delete(context, sid)
 
-	log.Debug("END client.Accounts.Delete")
+	log.Debug("END client.Keys.Delete")
 
 	if err != nil {
 		return fmt.Errorf("Failed to delete key: %s", err.Error())
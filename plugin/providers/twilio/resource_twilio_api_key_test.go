@@ -0,0 +1,97 @@
+package twilio
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("rotateApiKeyOnSchedule", func() {
+	format := "2006-01-02T15:04:05-07:00"
+	created := time.Now().AddDate(0, 0, -45).Truncate(time.Second)
+
+	plan := func(state map[string]interface{}, config map[string]interface{}) (bool, map[string]string) {
+		state["date_created"] = created.Format(format)
+
+		diff, err := planResource(resourceTwilioApiKey(), "SK123", state, config)
+		Expect(err).NotTo(HaveOccurred())
+
+		changes := make(map[string]string)
+		if diff == nil {
+			return false, changes
+		}
+		for key, attr := range diff.Attributes {
+			changes[key] = attr.New
+		}
+		return diff.RequiresNew(), changes
+	}
+
+	It("replaces an overdue key when rotation_days is added", func() {
+		requiresNew, _ := plan(map[string]interface{}{
+			"rotate_after": "",
+		}, map[string]interface{}{
+			"rotation_days": 30,
+		})
+		Expect(requiresNew).To(BeTrue())
+	})
+
+	It("replaces an overdue key whose rotate_after is empty", func() {
+		requiresNew, _ := plan(map[string]interface{}{
+			"rotation_days": 30,
+			"rotate_after":  "",
+		}, map[string]interface{}{
+			"rotation_days": 30,
+		})
+		Expect(requiresNew).To(BeTrue())
+	})
+
+	It("replaces an overdue key once rotate_after has passed", func() {
+		requiresNew, _ := plan(map[string]interface{}{
+			"rotation_days": 30,
+			"rotate_after":  created.AddDate(0, 0, 30).Format(format),
+		}, map[string]interface{}{
+			"rotation_days": 30,
+		})
+		Expect(requiresNew).To(BeTrue())
+	})
+
+	It("moves rotate_after when rotation_days changes before the key is due", func() {
+		requiresNew, changes := plan(map[string]interface{}{
+			"rotation_days": 60,
+			"rotate_after":  created.AddDate(0, 0, 60).Format(format),
+		}, map[string]interface{}{
+			"rotation_days": 90,
+		})
+		Expect(requiresNew).To(BeFalse())
+		Expect(changes).To(HaveKeyWithValue("rotate_after", created.AddDate(0, 0, 90).Format(format)))
+	})
+
+	It("leaves a key that is not due alone", func() {
+		requiresNew, changes := plan(map[string]interface{}{
+			"rotation_days": 60,
+			"rotate_after":  created.AddDate(0, 0, 60).Format(format),
+		}, map[string]interface{}{
+			"rotation_days": 60,
+		})
+		Expect(requiresNew).To(BeFalse())
+		Expect(changes).To(BeEmpty())
+	})
+})
+
+var _ = Describe("validateApiKeyGracePeriod", func() {
+	It("accepts grace periods up to the maximum", func() {
+		_, errs := validateApiKeyGracePeriod("30m", "grace_period")
+		Expect(errs).To(BeEmpty())
+	})
+
+	It("rejects grace periods above the maximum", func() {
+		_, errs := validateApiKeyGracePeriod("31m", "grace_period")
+		Expect(errs).To(HaveLen(1))
+	})
+
+	It("rejects malformed durations", func() {
+		_, errs := validateApiKeyGracePeriod("ten minutes", "grace_period")
+		Expect(errs).To(HaveLen(1))
+	})
+})
@@ -4,7 +4,8 @@ import (
 	"context"
 	"fmt"
 	"github.com/hashicorp/terraform/helper/schema"
-	"github.com/kevinburke/twilio-go"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/spf13/cast"
 	"net/url"
 
 	log "github.com/sirupsen/logrus"
@@ -26,6 +27,11 @@ func resourceTwilioMessagingService() *schema.Resource {
 				Computed:    true,
 				Description: "The unique identifier for this messaging service.",
 			},
+			"account_sid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "SID of the account that owns this messaging service.",
+			},
 			"friendly_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -42,66 +48,144 @@ func resourceTwilioMessagingService() *schema.Resource {
 				Description: "The date the messaging service was last updated.",
 			},
 			"inbound_request_url": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The URL we call using inbound_method when a message is received by any phone number or short code in the Service. When this property is null, receiving inbound messages is disabled. All messages sent to the Twilio phone number or short code will not be logged and received on the Account.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURL,
+				Description:  "The URL we call using inbound_method when a message is received by any phone number or short code in the Service. When this property is null, receiving inbound messages is disabled. All messages sent to the Twilio phone number or short code will not be logged and received on the Account.",
 			},
 			"inbound_method": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "POST",
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "POST",
+				ValidateFunc: validation.StringInSlice([]string{
+					"POST",
+					"GET",
+				}, false),
 				Description: "The HTTP method we use to call inbound_request_url. Can be GET or POST.",
 			},
 			"fallback_url": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "The URL that we call using fallback_method if an error occurs while retrieving or executing the TwiML from the Inbound Request URL.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURL,
+				Description:  "The URL that we call using fallback_method if an error occurs while retrieving or executing the TwiML from the Inbound Request URL.",
 			},
 			"fallback_method": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Default:     "POST",
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "POST",
+				ValidateFunc: validation.StringInSlice([]string{
+					"POST",
+					"GET",
+				}, false),
 				Description: "The HTTP method we use to call fallback_url. Can be: GET or POST.",
 			},
 			"status_callback": {
-				Type:        schema.TypeString,
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateURL,
+				Description:  "The URL we call to pass status updates about message delivery.",
+			},
+			"use_inbound_webhook_on_number": {
+				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "The URL we call to pass status updates about message delivery.",
+				Computed:    true,
+				Description: "If `true`, inbound messages are sent to the webhooks of the receiving phone number instead of inbound_request_url. Twilio defaults it to `false`.",
 			},
 			"sticky_sender": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Whether to enable Sticky Sender on the Service instance.",
+				Computed:    true,
+				Description: "Whether to enable Sticky Sender on the Service instance. Twilio defaults it to `true`; it is only changed when set.",
 			},
 			"mms_converter": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Whether to enable the MMS Converter for messages sent through the Service instance.",
+				Computed:    true,
+				Description: "Whether to enable the MMS Converter for messages sent through the Service instance. Twilio defaults it to `true`; it is only changed when set.",
 			},
 			"smart_encoding": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Whether to enable Smart Encoding for messages sent through the Service instance.",
+				Computed:    true,
+				Description: "Whether to enable Smart Encoding for messages sent through the Service instance. Twilio defaults it to `true`; it is only changed when set.",
+			},
+			"scan_message_content": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"inherit",
+					"enable",
+					"disable",
+				}, false),
+				Description: "Whether to scan the content of messages sent through the Service for fraud. Can be `inherit`, `enable` or `disable`.",
 			},
 			"fallback_to_long_code": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Whether to enable Fallback to Long Code for messages sent through the Service instance.",
+				Computed:    true,
+				Description: "Whether to enable Fallback to Long Code for messages sent through the Service instance. It is only changed when set.",
 			},
 			"area_code_geomatch": {
 				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "Whether to enable Area Code Geomatch on the Service Instance.",
+				Computed:    true,
+				Description: "Whether to enable Area Code Geomatch on the Service Instance. It is only changed when set.",
 			},
 			"synchronous_validation": {
 				Type:        schema.TypeBool,
 				Optional:    true,
+				Computed:    true,
 				Description: "Reserved.",
 			},
 			"validity_period": {
-				Type:        schema.TypeInt,
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(1, 14400),
+				Description:  "How long, in seconds, messages sent from the Service are valid. Can be an integer from 1 to 14,400.",
+			},
+			"usecase": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"notifications",
+					"marketing",
+					"verification",
+					"discussion",
+					"poll",
+					"undeclared",
+				}, false),
+				Description: "The intended use case of the Service. Can be `notifications`, `marketing`, `verification`, `discussion`, `poll` or `undeclared`.",
+			},
+			"us_app_to_person_registered": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether US A2P 10DLC messaging is registered for the Service.",
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The absolute URL of the messaging service.",
+			},
+			"links": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The URLs of the messaging service's sub-resources, e.g. `phone_numbers`, `short_codes` and `alpha_senders`.",
+			},
+			"detach_senders_on_destroy": {
+				Type:        schema.TypeBool,
 				Optional:    true,
-				Description: "How long, in seconds, messages sent from the Service are valid. Can be an integer from 1 to 14,400.",
+				Default:     false,
+				Description: "If `true`, every phone number, short code and alpha sender is removed from the Service before it is deleted. Defaults to `false`.",
+			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If `true`, destroying this resource fails. Defaults to `false`.",
 			},
 		},
 	}
@@ -111,23 +195,34 @@ func makeCreateServiceRequestPayload(d *schema.ResourceData) url.Values {
 	createServiceRequestPayload := make(url.Values)
 
 	addIfNotEmpty(createServiceRequestPayload, "FriendlyName", d.Get("friendly_name"))
-	addIfNotEmpty(createServiceRequestPayload, "InboundRequestUrl", d.Get("inbound_request_url"))
+	addOrClear(createServiceRequestPayload, "InboundRequestUrl", d.Get("inbound_request_url"), isCleared(d, "inbound_request_url"))
 	addIfNotEmpty(createServiceRequestPayload, "InboundMethod", d.Get("inbound_method"))
-	addIfNotEmpty(createServiceRequestPayload, "FallbackUrl", d.Get("fallback_url"))
+	addOrClear(createServiceRequestPayload, "FallbackUrl", d.Get("fallback_url"), isCleared(d, "fallback_url"))
 	addIfNotEmpty(createServiceRequestPayload, "FallbackMethod", d.Get("fallback_method"))
-	addIfNotEmpty(createServiceRequestPayload, "StatusCallback", d.Get("status_callback"))
-	addIfNotEmpty(createServiceRequestPayload, "StickySender", d.Get("sticky_sender"))
-	addIfNotEmpty(createServiceRequestPayload, "MmsConverter", d.Get("mms_converter"))
-	addIfNotEmpty(createServiceRequestPayload, "SmartEncoding", d.Get("smart_encoding"))
-	addIfNotEmpty(createServiceRequestPayload, "FallbackToLongCode", d.Get("fallback_to_long_code"))
-	addIfNotEmpty(createServiceRequestPayload, "AreaCodeGeomatch", d.Get("area_code_geomatch"))
-	addIfNotEmpty(createServiceRequestPayload, "ValidityPeriod", d.Get("validity_period"))
-	addIfNotEmpty(createServiceRequestPayload, "SynchronousValidation", d.Get("synchronous_validation"))
+	addOrClear(createServiceRequestPayload, "StatusCallback", d.Get("status_callback"), isCleared(d, "status_callback"))
+	addIfNotEmpty(createServiceRequestPayload, "ScanMessageContent", d.Get("scan_message_content"))
+	addIfNotEmpty(createServiceRequestPayload, "Usecase", d.Get("usecase"))
+
+	// Unset settings keep Twilio's defaults rather than being sent as false or 0.
+	for attribute, param := range map[string]string{
+		"use_inbound_webhook_on_number": "UseInboundWebhookOnNumber",
+		"sticky_sender":                 "StickySender",
+		"mms_converter":                 "MmsConverter",
+		"smart_encoding":                "SmartEncoding",
+		"fallback_to_long_code":         "FallbackToLongCode",
+		"area_code_geomatch":            "AreaCodeGeomatch",
+		"synchronous_validation":        "SynchronousValidation",
+		"validity_period":               "ValidityPeriod",
+	} {
+		if v, ok := d.GetOkExists(attribute); ok {
+			createServiceRequestPayload.Set(param, cast.ToString(v))
+		}
+	}
 
 	return createServiceRequestPayload
 }
 
-func mapTwilioMessagingServiceToTerraform(ms *twilio.Service, d *schema.ResourceData) error {
+func mapTwilioMessagingServiceToTerraform(ms *messagingService, d *schema.ResourceData) error {
 	err := d.Set("sid", ms.Sid)
 	if err == nil {
 		err = d.Set("account_sid", ms.AccountSid)
@@ -138,7 +233,7 @@ func mapTwilioMessagingServiceToTerraform(ms *twilio.Service, d *schema.Resource
 	if err == nil && ms.DateCreated.Valid {
 		err = d.Set("date_created", ms.DateCreated.Time.Format("2006-01-02T15:04:05-07:00"))
 	}
-	if ms.DateUpdated.Valid {
+	if err == nil && ms.DateUpdated.Valid {
 		err = d.Set("date_updated", ms.DateUpdated.Time.Format("2006-01-02T15:04:05-07:00"))
 	}
 	if err == nil && ms.InboundRequestURL != nil {
@@ -156,12 +251,18 @@ func mapTwilioMessagingServiceToTerraform(ms *twilio.Service, d *schema.Resource
 	if err == nil {
 		err = d.Set("status_callback", ms.StatusCallback)
 	}
+	if err == nil {
+		err = d.Set("use_inbound_webhook_on_number", ms.UseInboundWebhookOnNumber)
+	}
 	if err == nil {
 		err = d.Set("mms_converter", ms.MMSConverter)
 	}
 	if err == nil {
 		err = d.Set("smart_encoding", ms.SmartEncoding)
 	}
+	if err == nil {
+		err = d.Set("scan_message_content", ms.ScanMessageContent)
+	}
 	if err == nil {
 		err = d.Set("sticky_sender", ms.StickySender)
 	}
@@ -177,6 +278,18 @@ func mapTwilioMessagingServiceToTerraform(ms *twilio.Service, d *schema.Resource
 	if err == nil {
 		err = d.Set("synchronous_validation", ms.SynchronousValidation)
 	}
+	if err == nil {
+		err = d.Set("usecase", ms.Usecase)
+	}
+	if err == nil {
+		err = d.Set("us_app_to_person_registered", ms.UsAppToPersonRegistered)
+	}
+	if err == nil {
+		err = d.Set("url", ms.URL)
+	}
+	if err == nil {
+		err = d.Set("links", ms.Links)
+	}
 	return err
 }
 
@@ -194,7 +307,7 @@ func resourceTwilioMessagingServiceCreate(d *schema.ResourceData, meta interface
 		},
 	).Debug("START client.Message.Services.Create")
 
-	result, err := client.Message.Services.Create(context.TODO(), params)
+	result, err := createMessagingService(context.TODO(), client, params)
 
 	if err != nil {
 		log.WithFields(
@@ -243,7 +356,7 @@ func resourceTwilioMessagingServiceRead(d *schema.ResourceData, meta interface{}
 		},
 	).Debug("START client.IncomingNumbers.Get")
 
-	ph, err := client.Message.Services.Get(context.TODO(), sid)
+	ph, err := getMessagingService(context.TODO(), client, sid)
 
 	if err != nil {
 		return fmt.Errorf("Encountered an error when getting messaging service SID %s: %s", sid, err)
@@ -275,13 +388,20 @@ func resourceTwilioMessagingServiceUpdate(d *schema.ResourceData, meta interface
 		},
 	).Debug("START client.Message.Services.Update")
 
-	_, err := client.Message.Services.Update(context.TODO(), sid, updatePayload)
+	result, err := updateMessagingService(context.TODO(), client, sid, updatePayload)
 
 	if err != nil {
 		return fmt.Errorf("Failed to update messaging service SID %s: %s", sid, err)
 	}
 
-	return nil
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"service_sid": sid,
+		},
+	).Debug("END client.Message.Services.Update")
+
+	return mapTwilioMessagingServiceToTerraform(result, d)
 }
 
 func resourceTwilioMessagingServiceDelete(d *schema.ResourceData, meta interface{}) error {
@@ -292,6 +412,16 @@ func resourceTwilioMessagingServiceDelete(d *schema.ResourceData, meta interface
 
 	sid := d.Id()
 
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Messaging service %s has deletion_protection enabled, set it to false and apply before destroying", sid)
+	}
+
+	if d.Get("detach_senders_on_destroy").(bool) {
+		if err := detachMessagingServiceSenders(context.TODO(), meta, sid); err != nil {
+			return err
+		}
+	}
+
 	log.WithFields(
 		log.Fields{
 			"account_sid": config.AccountSID,
@@ -314,3 +444,39 @@ func resourceTwilioMessagingServiceDelete(d *schema.ResourceData, meta interface
 
 	return nil
 }
+
+// detachMessagingServiceSenders removes every phone number, short code and alpha sender from the messaging service.
+func detachMessagingServiceSenders(ctx context.Context, meta interface{}, serviceSid string) error {
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	for _, kind := range messagingServiceSenderKinds {
+		senders, err := listMessagingServiceSenders(ctx, client, serviceSid, kind)
+		if err != nil {
+			return fmt.Errorf("Encountered an error when listing %s of messaging service %s: %s", kind.pathPart, serviceSid, err)
+		}
+
+		for _, sender := range senders {
+			log.WithFields(
+				log.Fields{
+					"account_sid": config.AccountSID,
+					"service_sid": serviceSid,
+					"sender_sid":  sender.Sid,
+				},
+			).Debug("START client.Message.Services." + kind.pathPart + ".Delete")
+
+			if err := removeMessagingServiceSender(ctx, client, serviceSid, kind, sender.Sid); err != nil && !isNotFoundError(err) {
+				return fmt.Errorf("Encountered an error when removing sender %s from messaging service %s: %s", sender.Sid, serviceSid, err)
+			}
+
+			log.WithFields(
+				log.Fields{
+					"account_sid": config.AccountSID,
+					"service_sid": serviceSid,
+					"sender_sid":  sender.Sid,
+				},
+			).Debug("END client.Message.Services." + kind.pathPart + ".Delete")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,481 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/kevinburke/twilio-go"
+	"github.com/spf13/cast"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// a2pCampaign is the US A2P 10DLC campaign of a messaging service.
+type a2pCampaign struct {
+	Sid                    string                   `json:"sid"`
+	AccountSid             string                   `json:"account_sid"`
+	MessagingServiceSid    string                   `json:"messaging_service_sid"`
+	BrandRegistrationSid   string                   `json:"brand_registration_sid"`
+	Description            string                   `json:"description"`
+	MessageFlow            string                   `json:"message_flow"`
+	MessageSamples         []string                 `json:"message_samples"`
+	Usecase                string                   `json:"us_app_to_person_usecase"`
+	HasEmbeddedLinks       bool                     `json:"has_embedded_links"`
+	HasEmbeddedPhone       bool                     `json:"has_embedded_phone"`
+	OptInMessage           string                   `json:"opt_in_message"`
+	OptOutMessage          string                   `json:"opt_out_message"`
+	HelpMessage            string                   `json:"help_message"`
+	OptInKeywords          []string                 `json:"opt_in_keywords"`
+	OptOutKeywords         []string                 `json:"opt_out_keywords"`
+	HelpKeywords           []string                 `json:"help_keywords"`
+	CampaignStatus         string                   `json:"campaign_status"`
+	CampaignID             string                   `json:"campaign_id"`
+	IsExternallyRegistered bool                     `json:"is_externally_registered"`
+	Errors                 []map[string]interface{} `json:"errors"`
+	URL                    string                   `json:"url"`
+	DateCreated            twilio.TwilioTime        `json:"date_created"`
+	DateUpdated            twilio.TwilioTime        `json:"date_updated"`
+}
+
+// a2pCampaignPendingStatuses are the statuses of a campaign whose review is not finished.
+var a2pCampaignPendingStatuses = []string{"PENDING", "IN_PROGRESS"}
+
+func a2pCampaignPath(serviceSid string) string {
+	return strings.Join([]string{"Services", serviceSid, "Compliance", "Usa2p"}, "/")
+}
+
+// failureReasons flattens the errors reported by the campaign review into one string per error.
+func (c *a2pCampaign) failureReasons() []string {
+	reasons := make([]string, 0, len(c.Errors))
+	for _, e := range c.Errors {
+		description := cast.ToString(e["description"])
+		if code := cast.ToString(e["error_code"]); code != "" {
+			description = fmt.Sprintf("%s: %s", code, description)
+		}
+		reasons = append(reasons, description)
+	}
+	return reasons
+}
+
+func resourceTwilioMessagingServiceA2PCampaign() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTwilioMessagingServiceA2PCampaignCreate,
+		Read:   resourceTwilioMessagingServiceA2PCampaignRead,
+		Update: resourceTwilioMessagingServiceA2PCampaignUpdate,
+		Delete: resourceTwilioMessagingServiceA2PCampaignDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTwilioMessagingServiceA2PCampaignImport,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"messaging_service_sid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "SID of the messaging service (MG...) the campaign is registered for.",
+			},
+			"brand_registration_sid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "SID of the approved A2P brand registration (BN...) the campaign belongs to, e.g. `twilio_a2p_brand_registration.brand.sid`.",
+			},
+			"description": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(40, 4096),
+				Description:  "What the campaign is used for.",
+			},
+			"message_flow": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(40, 2048),
+				Description:  "How end users opt in to the campaign.",
+			},
+			"message_samples": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    2,
+				MaxItems:    5,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validation.StringLenBetween(20, 1024)},
+				Description: "Between 2 and 5 sample messages sent by the campaign.",
+			},
+			"usecase": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The A2P campaign use case, e.g. `MARKETING`, `ACCOUNT_NOTIFICATION` or `2FA`.",
+			},
+			"has_embedded_links": {
+				Type:     schema.TypeBool,
+				Required: true,
+				ForceNew: true,
+			},
+			"has_embedded_phone": {
+				Type:     schema.TypeBool,
+				Required: true,
+				ForceNew: true,
+			},
+			"opt_in_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"opt_out_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"help_message": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"opt_in_keywords": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"opt_out_keywords": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"help_keywords": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_approval": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If `true`, creating the campaign waits until its review completes, failing once the create timeout elapses. Review can take days, so raise the create timeout accordingly. A campaign that is not verified is reported through `campaign_status` and `failure_reasons` rather than failing the apply. Defaults to `false`.",
+			},
+			"sid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier (QE...) of this campaign.",
+			},
+			"account_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"campaign_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The review status of the campaign, e.g. `PENDING`, `IN_PROGRESS`, `VERIFIED` or `FAILED`.",
+			},
+			"campaign_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The campaign's ID in The Campaign Registry.",
+			},
+			"is_externally_registered": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"failure_reasons": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Why the campaign review failed, if it did.",
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func getA2PCampaign(ctx context.Context, client *twilio.Client, serviceSid string, sid string) (*a2pCampaign, error) {
+	campaign := new(a2pCampaign)
+	err := client.Message.GetResource(ctx, a2pCampaignPath(serviceSid), sid, campaign)
+	return campaign, err
+}
+
+// parseA2PCampaignID splits a campaign ID of the form `MG.../QE...`.
+func parseA2PCampaignID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("A2P campaign ID %s is not of the form <messaging service SID>/<campaign SID>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func mapTwilioA2PCampaignToTerraform(campaign *a2pCampaign, d *schema.ResourceData) error {
+	err := d.Set("sid", campaign.Sid)
+	if err == nil {
+		err = d.Set("account_sid", campaign.AccountSid)
+	}
+	if err == nil {
+		err = d.Set("messaging_service_sid", campaign.MessagingServiceSid)
+	}
+	if err == nil {
+		err = d.Set("brand_registration_sid", campaign.BrandRegistrationSid)
+	}
+	if err == nil {
+		err = d.Set("description", campaign.Description)
+	}
+	if err == nil {
+		err = d.Set("message_flow", campaign.MessageFlow)
+	}
+	if err == nil {
+		err = d.Set("message_samples", campaign.MessageSamples)
+	}
+	if err == nil {
+		err = d.Set("usecase", campaign.Usecase)
+	}
+	if err == nil {
+		err = d.Set("has_embedded_links", campaign.HasEmbeddedLinks)
+	}
+	if err == nil {
+		err = d.Set("has_embedded_phone", campaign.HasEmbeddedPhone)
+	}
+	if err == nil {
+		err = d.Set("opt_in_message", campaign.OptInMessage)
+	}
+	if err == nil {
+		err = d.Set("opt_out_message", campaign.OptOutMessage)
+	}
+	if err == nil {
+		err = d.Set("help_message", campaign.HelpMessage)
+	}
+	if err == nil {
+		err = d.Set("opt_in_keywords", campaign.OptInKeywords)
+	}
+	if err == nil {
+		err = d.Set("opt_out_keywords", campaign.OptOutKeywords)
+	}
+	if err == nil {
+		err = d.Set("help_keywords", campaign.HelpKeywords)
+	}
+	if err == nil {
+		err = d.Set("campaign_status", campaign.CampaignStatus)
+	}
+	if err == nil {
+		err = d.Set("campaign_id", campaign.CampaignID)
+	}
+	if err == nil {
+		err = d.Set("is_externally_registered", campaign.IsExternallyRegistered)
+	}
+	if err == nil {
+		err = d.Set("failure_reasons", campaign.failureReasons())
+	}
+	if err == nil {
+		err = d.Set("url", campaign.URL)
+	}
+	if err == nil && campaign.DateCreated.Valid {
+		err = d.Set("date_created", campaign.DateCreated.Time.Format("2006-01-02T15:04:05-07:00"))
+	}
+	if err == nil && campaign.DateUpdated.Valid {
+		err = d.Set("date_updated", campaign.DateUpdated.Time.Format("2006-01-02T15:04:05-07:00"))
+	}
+	return err
+}
+
+func resourceTwilioMessagingServiceA2PCampaignCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioMessagingServiceA2PCampaignCreate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	ctx := context.TODO()
+
+	serviceSid := d.Get("messaging_service_sid").(string)
+
+	params := make(url.Values)
+	addIfNotEmpty(params, "BrandRegistrationSid", d.Get("brand_registration_sid"))
+	addIfNotEmpty(params, "Description", d.Get("description"))
+	addIfNotEmpty(params, "MessageFlow", d.Get("message_flow"))
+	addIfNotEmpty(params, "UsAppToPersonUsecase", d.Get("usecase"))
+	params.Set("HasEmbeddedLinks", cast.ToString(d.Get("has_embedded_links")))
+	params.Set("HasEmbeddedPhone", cast.ToString(d.Get("has_embedded_phone")))
+	addIfNotEmpty(params, "OptInMessage", d.Get("opt_in_message"))
+	addIfNotEmpty(params, "OptOutMessage", d.Get("opt_out_message"))
+	addIfNotEmpty(params, "HelpMessage", d.Get("help_message"))
+	for _, sample := range d.Get("message_samples").([]interface{}) {
+		params.Add("MessageSamples", cast.ToString(sample))
+	}
+	for _, keyword := range d.Get("opt_in_keywords").([]interface{}) {
+		params.Add("OptInKeywords", cast.ToString(keyword))
+	}
+	for _, keyword := range d.Get("opt_out_keywords").([]interface{}) {
+		params.Add("OptOutKeywords", cast.ToString(keyword))
+	}
+	for _, keyword := range d.Get("help_keywords").([]interface{}) {
+		params.Add("HelpKeywords", cast.ToString(keyword))
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"service_sid": serviceSid,
+		},
+	).Debug("START client.Message.Services.Usa2p.Create")
+
+	campaign := new(a2pCampaign)
+	if err := client.Message.CreateResource(ctx, a2pCampaignPath(serviceSid), params, campaign); err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"service_sid": serviceSid,
+			},
+		).WithError(err).Error("ERROR client.Message.Services.Usa2p.Create")
+
+		return fmt.Errorf("Encountered an error when registering A2P campaign for messaging service SID %s: %s", serviceSid, err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":  config.AccountSID,
+			"service_sid":  serviceSid,
+			"campaign_sid": campaign.Sid,
+		},
+	).Debug("END client.Message.Services.Usa2p.Create")
+
+	d.SetId(strings.Join([]string{serviceSid, campaign.Sid}, "/"))
+
+	if err := mapTwilioA2PCampaignToTerraform(campaign, d); err != nil {
+		return fmt.Errorf("Encountered error while reading result for A2P campaign SID %s and mapping it to TF: %s", campaign.Sid, err)
+	}
+
+	if !d.Get("wait_for_approval").(bool) {
+		return nil
+	}
+
+	sid := campaign.Sid
+	return waitForApproval(fmt.Sprintf("A2P campaign %s", sid), d.Timeout(schema.TimeoutCreate), a2pCampaignPendingStatuses, "VERIFIED", func() (string, error) {
+		latest, err := getA2PCampaign(ctx, client, serviceSid, sid)
+		if err != nil {
+			return "", fmt.Errorf("Encountered an error when getting A2P campaign SID %s: %s", sid, err)
+		}
+		if err := mapTwilioA2PCampaignToTerraform(latest, d); err != nil {
+			return "", fmt.Errorf("Encountered an error while mapping Twilio API result to terraform: %s", err)
+		}
+		return latest.CampaignStatus, nil
+	})
+}
+
+func resourceTwilioMessagingServiceA2PCampaignRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioMessagingServiceA2PCampaignRead")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	serviceSid, sid, err := parseA2PCampaignID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":  config.AccountSID,
+			"service_sid":  serviceSid,
+			"campaign_sid": sid,
+		},
+	).Debug("START client.Message.Services.Usa2p.Get")
+
+	campaign, err := getA2PCampaign(context.TODO(), client, serviceSid, sid)
+	if isNotFoundError(err) {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("Encountered an error when getting A2P campaign SID %s: %s", sid, err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":     config.AccountSID,
+			"service_sid":     serviceSid,
+			"campaign_sid":    sid,
+			"campaign_status": campaign.CampaignStatus,
+		},
+	).Debug("END client.Message.Services.Usa2p.Get")
+
+	if err := mapTwilioA2PCampaignToTerraform(campaign, d); err != nil {
+		return fmt.Errorf("Encountered an error while mapping Twilio API result to terraform: %s", err)
+	}
+	return nil
+}
+
+// resourceTwilioMessagingServiceA2PCampaignUpdate has nothing to send: every other attribute forces a new campaign.
+func resourceTwilioMessagingServiceA2PCampaignUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioMessagingServiceA2PCampaignUpdate")
+
+	return resourceTwilioMessagingServiceA2PCampaignRead(d, meta)
+}
+
+func resourceTwilioMessagingServiceA2PCampaignDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioMessagingServiceA2PCampaignDelete")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	serviceSid, sid, err := parseA2PCampaignID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":  config.AccountSID,
+			"service_sid":  serviceSid,
+			"campaign_sid": sid,
+		},
+	).Debug("START client.Message.Services.Usa2p.Delete")
+
+	if err := client.Message.DeleteResource(context.TODO(), a2pCampaignPath(serviceSid), sid); err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid":  config.AccountSID,
+				"service_sid":  serviceSid,
+				"campaign_sid": sid,
+			},
+		).WithError(err).Error("ERROR client.Message.Services.Usa2p.Delete")
+
+		return fmt.Errorf("Encountered an error when deleting A2P campaign SID %s: %s", sid, err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":  config.AccountSID,
+			"service_sid":  serviceSid,
+			"campaign_sid": sid,
+		},
+	).Debug("END client.Message.Services.Usa2p.Delete")
+
+	return nil
+}
+
+func resourceTwilioMessagingServiceA2PCampaignImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseA2PCampaignID(d.Id()); err != nil {
+		return nil, err
+	}
+	if err := d.Set("wait_for_approval", false); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
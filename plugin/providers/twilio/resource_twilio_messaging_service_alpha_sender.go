@@ -0,0 +1,50 @@
+package twilio
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func resourceTwilioMessagingServiceAlphaSender() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTwilioMessagingServiceAlphaSenderCreate,
+		Read:   resourceTwilioMessagingServiceSenderRead,
+		Delete: resourceTwilioMessagingServiceSenderDelete,
+		Importer: &schema.ResourceImporter{
+			State: importTwilioMessagingServiceSender(messagingServiceAlphaSenderKind),
+		},
+
+		Schema: withMessagingServiceSenderAttributes(map[string]*schema.Schema{
+			"service_sid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "SID of the messaging service the alpha sender is added to.",
+			},
+			"alpha_sender": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAlphaSender,
+				Description:  "Alphanumeric sender ID to add to the messaging service. Up to 11 letters, digits or spaces.",
+			},
+		}),
+	}
+}
+
+func resourceTwilioMessagingServiceAlphaSenderCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioMessagingServiceAlphaSenderCreate")
+
+	sender, err := createTwilioMessagingServiceSender(d, meta, messagingServiceAlphaSenderKind, d.Get("alpha_sender").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := mapTwilioMessagingServiceSenderResourceToTerraform(sender, messagingServiceAlphaSenderKind, d); err != nil {
+		return fmt.Errorf("Encountered error while reading result for alpha sender SID %s of messaging service SID %s and mapping it to TF: %s", sender.Sid, sender.ServiceSid, err)
+	}
+	return nil
+}
@@ -0,0 +1,352 @@
+package twilio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func resourceTwilioMessagingServiceSender() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTwilioMessagingServiceSenderCreate,
+		Read:   resourceTwilioMessagingServiceSenderRead,
+		Delete: resourceTwilioMessagingServiceSenderDelete,
+		Importer: &schema.ResourceImporter{
+			State: importTwilioMessagingServiceSender(nil),
+		},
+
+		Schema: withMessagingServiceSenderAttributes(map[string]*schema.Schema{
+			"service_sid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "SID of the messaging service the sender is added to.",
+			},
+			"phone_number_sid": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"short_code_sid", "alpha_sender"},
+				Description:   "SID of the phone number to add to the messaging service. Exactly one of `phone_number_sid`, `short_code_sid` or `alpha_sender` must be set.",
+			},
+			"short_code_sid": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"phone_number_sid", "alpha_sender"},
+				Description:   "SID of the short code to add to the messaging service.",
+			},
+			"alpha_sender": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"phone_number_sid", "short_code_sid"},
+				ValidateFunc:  validateAlphaSender,
+				Description:   "Alphanumeric sender ID to add to the messaging service. Up to 11 letters, digits or spaces.",
+			},
+		}),
+	}
+}
+
+// withMessagingServiceSenderAttributes adds the computed attributes shared by every kind of messaging service sender to
+// the given schema.
+func withMessagingServiceSenderAttributes(s map[string]*schema.Schema) map[string]*schema.Schema {
+	for key, attribute := range map[string]*schema.Schema{
+		"sid": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "SID of the sender within the messaging service.",
+		},
+		"account_sid": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "SID of the account that owns the messaging service.",
+		},
+		"sender": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The E.164 phone number, short code or alpha sender ID messages are sent from.",
+		},
+		"country_code": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Two letter ISO country code of the sender.",
+		},
+		"capabilities": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The messaging capabilities of the sender.",
+		},
+		"date_created": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date the sender was added to the messaging service.",
+		},
+		"date_updated": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date the sender was last updated.",
+		},
+	} {
+		s[key] = attribute
+	}
+	return s
+}
+
+var validateAlphaSender = validation.StringMatch(regexp.MustCompile(`^[A-Za-z0-9 ]{1,11}$`), "must be 1 to 11 letters, digits or spaces")
+
+// parseMessagingServiceSenderID splits the `<service SID>/<sender SID>` ID of a messaging service sender.
+func parseMessagingServiceSenderID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("Invalid ID %q, expected <service SID>/<sender SID>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// mapTwilioMessagingServiceSenderToTerraform maps the attributes shared by every kind of messaging service sender.
+func mapTwilioMessagingServiceSenderToTerraform(sender *messagingServiceSender, d *schema.ResourceData) error {
+	err := d.Set("sid", sender.Sid)
+	if err == nil {
+		err = d.Set("account_sid", sender.AccountSid)
+	}
+	if err == nil {
+		err = d.Set("service_sid", sender.ServiceSid)
+	}
+	if err == nil {
+		err = d.Set("country_code", sender.CountryCode)
+	}
+	if err == nil {
+		err = d.Set("capabilities", sender.Capabilities)
+	}
+	if err == nil && sender.DateCreated.Valid {
+		err = d.Set("date_created", sender.DateCreated.Time.Format("2006-01-02T15:04:05-07:00"))
+	}
+	if err == nil && sender.DateUpdated.Valid {
+		err = d.Set("date_updated", sender.DateUpdated.Time.Format("2006-01-02T15:04:05-07:00"))
+	}
+	return err
+}
+
+func mapTwilioMessagingServiceSenderResourceToTerraform(sender *messagingServiceSender, kind *messagingServiceSenderKind, d *schema.ResourceData) error {
+	err := mapTwilioMessagingServiceSenderToTerraform(sender, d)
+	if err == nil {
+		switch kind {
+		case messagingServicePhoneNumberKind:
+			err = d.Set("phone_number_sid", sender.Sid)
+			if err == nil {
+				err = d.Set("sender", sender.PhoneNumber)
+			}
+		case messagingServiceShortCodeKind:
+			err = d.Set("short_code_sid", sender.Sid)
+			if err == nil {
+				err = d.Set("sender", sender.ShortCode)
+			}
+		case messagingServiceAlphaSenderKind:
+			err = d.Set("alpha_sender", sender.AlphaSender)
+			if err == nil {
+				err = d.Set("sender", sender.AlphaSender)
+			}
+		}
+	}
+	return err
+}
+
+// importTwilioMessagingServiceSender returns the importer of messaging service senders of the given kind, or of any kind
+// if kind is nil.
+func importTwilioMessagingServiceSender(kind *messagingServiceSenderKind) schema.StateFunc {
+	return func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+		serviceSid, sid, err := parseMessagingServiceSenderID(d.Id())
+		if err != nil {
+			return nil, err
+		}
+		senderKind, err := messagingServiceSenderKindOf(sid)
+		if err != nil {
+			return nil, err
+		}
+		if kind != nil && senderKind != kind {
+			return nil, fmt.Errorf("Invalid ID %q, expected <service SID>/%s...", d.Id(), kind.sidPrefix)
+		}
+		if err := d.Set("service_sid", serviceSid); err != nil {
+			return nil, err
+		}
+		return []*schema.ResourceData{d}, nil
+	}
+}
+
+func resourceTwilioMessagingServiceSenderCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioMessagingServiceSenderCreate")
+
+	var kind *messagingServiceSenderKind
+	var value string
+	if v := d.Get("phone_number_sid").(string); v != "" {
+		kind, value = messagingServicePhoneNumberKind, v
+	} else if v := d.Get("short_code_sid").(string); v != "" {
+		kind, value = messagingServiceShortCodeKind, v
+	} else if v := d.Get("alpha_sender").(string); v != "" {
+		kind, value = messagingServiceAlphaSenderKind, v
+	} else {
+		return errors.New("One of 'phone_number_sid', 'short_code_sid' or 'alpha_sender' must be specified")
+	}
+
+	sender, err := createTwilioMessagingServiceSender(d, meta, kind, value)
+	if err != nil {
+		return err
+	}
+
+	if err := mapTwilioMessagingServiceSenderResourceToTerraform(sender, kind, d); err != nil {
+		return fmt.Errorf("Encountered error while reading result for sender SID %s of messaging service SID %s and mapping it to TF: %s", sender.Sid, sender.ServiceSid, err)
+	}
+	return nil
+}
+
+// createTwilioMessagingServiceSender adds a sender of the given kind to the messaging service in `service_sid` and sets
+// the resource ID.
+func createTwilioMessagingServiceSender(d *schema.ResourceData, meta interface{}, kind *messagingServiceSenderKind, value string) (*messagingServiceSender, error) {
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	serviceSid := d.Get("service_sid").(string)
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"service_sid": serviceSid,
+			"sender":      value,
+		},
+	).Debug(fmt.Sprintf("START client.Message.Services.Create%s", kind.pathPart))
+
+	sender, err := addMessagingServiceSender(context.TODO(), client, serviceSid, kind, value)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"service_sid": serviceSid,
+				"sender":      value,
+			},
+		).WithError(err).Error(fmt.Sprintf("ERROR client.Message.Services.Create%s", kind.pathPart))
+
+		return nil, fmt.Errorf("Encountered error adding %s to messaging service with SID %s: %s", value, serviceSid, err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"service_sid": serviceSid,
+			"sender_sid":  sender.Sid,
+		},
+	).Debug(fmt.Sprintf("END client.Message.Services.Create%s", kind.pathPart))
+
+	d.SetId(serviceSid + "/" + sender.Sid)
+	return sender, nil
+}
+
+// readTwilioMessagingServiceSender fetches the sender in the resource ID. A nil sender is returned when it is no
+// longer part of the messaging service, after the resource is removed from state.
+func readTwilioMessagingServiceSender(d *schema.ResourceData, meta interface{}) (*messagingServiceSender, *messagingServiceSenderKind, error) {
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	serviceSid, sid, err := parseMessagingServiceSenderID(d.Id())
+	if err != nil {
+		return nil, nil, err
+	}
+	kind, err := messagingServiceSenderKindOf(sid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"service_sid": serviceSid,
+			"sender_sid":  sid,
+		},
+	).Debug(fmt.Sprintf("START client.Message.Services.Get%s", kind.pathPart))
+
+	sender, err := getMessagingServiceSender(context.TODO(), client, serviceSid, kind, sid)
+	if isNotFoundError(err) {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"service_sid": serviceSid,
+				"sender_sid":  sid,
+			},
+		).Warn("Sender is no longer part of the messaging service, removing it from state")
+
+		d.SetId("")
+		return nil, kind, nil
+	} else if err != nil {
+		return nil, nil, fmt.Errorf("Encountered an error when getting sender SID %s of messaging service SID %s: %s", sid, serviceSid, err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"service_sid": serviceSid,
+			"sender_sid":  sid,
+		},
+	).Debug(fmt.Sprintf("END client.Message.Services.Get%s", kind.pathPart))
+
+	return sender, kind, nil
+}
+
+func resourceTwilioMessagingServiceSenderRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioMessagingServiceSenderRead")
+
+	sender, kind, err := readTwilioMessagingServiceSender(d, meta)
+	if err != nil || sender == nil {
+		return err
+	}
+
+	if err := mapTwilioMessagingServiceSenderResourceToTerraform(sender, kind, d); err != nil {
+		return fmt.Errorf("Encountered an error while mapping Twilio API result to terraform: %s", err)
+	}
+	return nil
+}
+
+func resourceTwilioMessagingServiceSenderDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioMessagingServiceSenderDelete")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	serviceSid, sid, err := parseMessagingServiceSenderID(d.Id())
+	if err != nil {
+		return err
+	}
+	kind, err := messagingServiceSenderKindOf(sid)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"service_sid": serviceSid,
+			"sender_sid":  sid,
+		},
+	).Debug(fmt.Sprintf("START client.Message.Services.Delete%s", kind.pathPart))
+
+	if err := removeMessagingServiceSender(context.TODO(), client, serviceSid, kind, sid); err != nil {
+		return fmt.Errorf("Encountered error removing sender with SID %s from messaging service with SID %s: %s", sid, serviceSid, err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"service_sid": serviceSid,
+			"sender_sid":  sid,
+		},
+	).Debug(fmt.Sprintf("END client.Message.Services.Delete%s", kind.pathPart))
+
+	return nil
+}
@@ -0,0 +1,49 @@
+package twilio
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	log "github.com/sirupsen/logrus"
+)
+
+func resourceTwilioMessagingServiceShortCode() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTwilioMessagingServiceShortCodeCreate,
+		Read:   resourceTwilioMessagingServiceSenderRead,
+		Delete: resourceTwilioMessagingServiceSenderDelete,
+		Importer: &schema.ResourceImporter{
+			State: importTwilioMessagingServiceSender(messagingServiceShortCodeKind),
+		},
+
+		Schema: withMessagingServiceSenderAttributes(map[string]*schema.Schema{
+			"service_sid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "SID of the messaging service the short code is added to.",
+			},
+			"short_code_sid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "SID of the short code to add to the messaging service.",
+			},
+		}),
+	}
+}
+
+func resourceTwilioMessagingServiceShortCodeCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioMessagingServiceShortCodeCreate")
+
+	sender, err := createTwilioMessagingServiceSender(d, meta, messagingServiceShortCodeKind, d.Get("short_code_sid").(string))
+	if err != nil {
+		return err
+	}
+
+	if err := mapTwilioMessagingServiceSenderResourceToTerraform(sender, messagingServiceShortCodeKind, d); err != nil {
+		return fmt.Errorf("Encountered error while reading result for short code SID %s of messaging service SID %s and mapping it to TF: %s", sender.Sid, sender.ServiceSid, err)
+	}
+	return nil
+}
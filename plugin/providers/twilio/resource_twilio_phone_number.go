@@ -5,6 +5,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"github.com/hashicorp/terraform/helper/customdiff"
 	"github.com/hashicorp/terraform/helper/hashcode"
 	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/helper/validation"
@@ -23,8 +24,14 @@ func resourceTwilioPhoneNumber() *schema.Resource {
 		Update: resourceTwilioPhoneNumberUpdate,
 		Delete: resourceTwilioPhoneNumberDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceTwilioPhoneNumberImport,
 		},
+		CustomizeDiff: customdiff.All(
+			validatePhoneNumberSource,
+			validatePhoneNumberRouting,
+			validatePhoneNumberAddressRequirements,
+			forceNewOnPhoneNumberSearchChange,
+		),
 
 		Schema: map[string]*schema.Schema{
 			"sid": {
@@ -33,14 +40,16 @@ func resourceTwilioPhoneNumber() *schema.Resource {
 				Description: "The unique identifier for this phone number.",
 			},
 			"search": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Look for this number sequence anywhere in the phone number.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressPhoneNumberSearchChange,
+				Description:      "Look for this number sequence anywhere in the phone number.",
 			},
 			"area_code": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Look for a number within this area code.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressPhoneNumberSearchChange,
+				Description:      "Look for a number within this area code.",
 			},
 			"type": {
 				Type:     schema.TypeString,
@@ -51,11 +60,69 @@ func resourceTwilioPhoneNumber() *schema.Resource {
 					"Mobile",
 					"TollFree",
 				}, false),
+				DiffSuppressFunc: suppressPhoneNumberSearchChange,
 			},
 			"country_code": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressPhoneNumberSearchChange,
+				Description:      "Two letter ISO country code in which you want to search for a number. See https://support.twilio.com/hc/en-us/articles/223183068-Twilio-international-phone-number-availability-and-their-capabilities for details on available countries. Required unless `existing_number` is set.",
+			},
+			"account_sid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "SID of the account (or subaccount) that owns this number. Defaults to the provider's account. Changing it transfers the number to the new account.",
+			},
+			"existing_number": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"country_code", "area_code", "search", "phone_number"},
+				Description:   "E.164 representation of a number already owned by the account (e.g. one ported into Twilio). When set, the number is adopted and configured instead of purchased.",
+			},
+			"phone_number": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ConflictsWith:    []string{"country_code", "area_code", "search", "existing_number"},
+				DiffSuppressFunc: suppressPurchasedPhoneNumberChange,
+				Description:      "E.164 representation of an available number to purchase, e.g. one returned by the `twilio_available_phone_numbers` data source. When set, exactly this number is bought instead of the first one matching a search.",
+			},
+			"release_on_destroy": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Deprecated:    "Use `on_destroy` instead: `true` is `release` and `false` is `detach`.",
+				ConflictsWith: []string{"on_destroy"},
+				Description:   "Whether destroying this resource releases the number. If `false`, destroy only detaches it.",
+			},
+			"on_destroy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"release",
+					"detach",
+					"park",
+				}, false),
+				Description: "What happens to the number on destroy. `release` gives it back to Twilio, `detach` clears its webhooks and applications and removes it from every messaging service, and `park` also strips its trunk and renames it to `park_friendly_name`. Defaults to `detach` for numbers adopted with `existing_number`, which could not be bought back once released, and to `release` otherwise.",
+			},
+			"park_friendly_name": {
 				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Two letter ISO country code in which you want to search for a number. See https://support.twilio.com/hc/en-us/articles/223183068-Twilio-international-phone-number-availability-and-their-capabilities for details on available countries.",
+				Optional:    true,
+				Default:     "Parked",
+				Description: "The friendly name given to the number when it is parked on destroy. Defaults to `Parked`.",
+			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If `true`, destroying this resource fails. Defaults to `false`.",
+			},
+			"replace_on_search_change": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If `true`, changing `country_code`, `type`, `area_code` or `search` after purchase releases the number and buys a new one. If `false`, such changes are ignored after purchase. Defaults to `false`.",
 			},
 			"number": {
 				Type:        schema.TypeString,
@@ -65,6 +132,7 @@ func resourceTwilioPhoneNumber() *schema.Resource {
 			"friendly_name": {
 				Type:        schema.TypeString,
 				Optional:    true,
+				Computed:    true,
 				Description: "A friendly, human-readable name by which you can refer to this number.",
 			},
 			"date_created": {
@@ -80,7 +148,7 @@ func resourceTwilioPhoneNumber() *schema.Resource {
 			"service_sid": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "The SID of the Service the resource is associated with.",
+				Description: "The SID of the Service the resource is associated with. Use `twilio_messaging_service_sender` instead to manage the membership separately from the number.",
 			},
 			"address_requirements": {
 				Type:        schema.TypeString,
@@ -107,11 +175,38 @@ func resourceTwilioPhoneNumber() *schema.Resource {
 				Computed:    true,
 				Description: "Whether or not this phone number is voice-capable..",
 			},
+			"is_fax_capable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether or not this phone number is fax-capable.",
+			},
+			"bundle_sid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "SID of the regulatory bundle associated with this phone number. May be required for certain countries.",
+			},
+			"emergency_address_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Whether the emergency address of this phone number has been registered.",
+			},
+			"origin": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Whether this number was purchased from Twilio (`twilio`) or hosted elsewhere (`hosted`).",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The provisioning status of this phone number.",
+			},
 			"sms": {
 				Type:     schema.TypeSet,
 				MinItems: 0,
 				MaxItems: 1,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"application_sid": {
@@ -130,20 +225,26 @@ func resourceTwilioPhoneNumber() *schema.Resource {
 							Description: "The HTTP method for the primary URL. Can be `GET` or `POST`, defaults to `POST`.",
 						},
 						"primary_url": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The URL called when an SMS is sent to this number.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateURL,
+							Description:  "The URL called when an SMS is sent to this number.",
 						},
 						"fallback_http_method": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Default:     "POST",
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "POST",
+							ValidateFunc: validation.StringInSlice([]string{
+								"POST",
+								"GET",
+							}, false),
 							Description: "The HTTP method for the fallback URL. Can be `GET` or `POST`, defaults to `POST`.",
 						},
 						"fallback_url": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The URL called if the primary URL returns a non-favorable status code.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateURL,
+							Description:  "The URL called if the primary URL returns a non-favorable status code.",
 						},
 					},
 				},
@@ -153,12 +254,14 @@ func resourceTwilioPhoneNumber() *schema.Resource {
 				MinItems: 0,
 				MaxItems: 1,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"url": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The URL called when a whenever a status change occurs on this number.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateURL,
+							Description:  "The URL called when a whenever a status change occurs on this number.",
 						},
 						"http_method": {
 							Type:     schema.TypeString,
@@ -178,6 +281,7 @@ func resourceTwilioPhoneNumber() *schema.Resource {
 				MinItems: 0,
 				MaxItems: 1,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"application_sid": {
@@ -196,9 +300,10 @@ func resourceTwilioPhoneNumber() *schema.Resource {
 							Description: "The HTTP method for the primary URL. Can be `GET` or `POST`, defaults to `POST`.",
 						},
 						"primary_url": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The URL called when a phone call starts on this number.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateURL,
+							Description:  "The URL called when a phone call starts on this number.",
 						},
 						"fallback_http_method": {
 							Type:     schema.TypeString,
@@ -211,9 +316,10 @@ func resourceTwilioPhoneNumber() *schema.Resource {
 							Description: "The HTTP method for the fallback URL. Can be `GET` or `POST`, defaults to `POST`.",
 						},
 						"fallback_url": {
-							Type:        schema.TypeString,
-							Optional:    true,
-							Description: "The URL called if the primary URL returns a non-favorable status code.",
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: validateURL,
+							Description:  "The URL called if the primary URL returns a non-favorable status code.",
 						},
 						"caller_id_enabled": {
 							Type:        schema.TypeBool,
@@ -221,8 +327,13 @@ func resourceTwilioPhoneNumber() *schema.Resource {
 							Description: "If caller ID is enabled or not for this number. If enabled, incurs additional charge per call (see console for pricing). Can be `true` or `false`, defaults to `false`.",
 						},
 						"receive_mode": {
-							Type:        schema.TypeString,
-							Optional:    true,
+							Type:     schema.TypeString,
+							Optional: true,
+							Default:  "voice",
+							ValidateFunc: validation.StringInSlice([]string{
+								"voice",
+								"fax",
+							}, false),
 							Description: "Determines if the line is set up for voice or fax. Can be `voice` or `fax`, defaults to `voice`.",
 						},
 					},
@@ -234,9 +345,11 @@ func resourceTwilioPhoneNumber() *schema.Resource {
 				Description: "SID of the address associated with this phone number. May be required for certain countries.",
 			},
 			"trunk_sid": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "SID of the voice trunk that will handle calls to this number. If set, overrides any voice URLs or applications: only the trunk will recieve the incoming call.",
+				Type:     schema.TypeString,
+				Optional: true,
+				// Checked against the configuration only, since `voice` also holds what was read back from the API
+				ConflictsWith: []string{"voice.0.application_sid", "voice.0.primary_url"},
+				Description:   "SID of the voice trunk that will handle calls to this number. If set, overrides any voice URLs or applications: only the trunk will recieve the incoming call, so it cannot be configured together with `voice.application_sid` or `voice.primary_url`.",
 			},
 			"identity_sid": {
 				Type:        schema.TypeString,
@@ -248,6 +361,7 @@ func resourceTwilioPhoneNumber() *schema.Resource {
 				MinItems: 0,
 				MaxItems: 1,
 				Optional: true,
+				Computed: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"status": {
@@ -269,6 +383,161 @@ func resourceTwilioPhoneNumber() *schema.Resource {
 	}
 }
 
+// phoneNumberSearchAttributes lists the arguments that only select which number is purchased.
+var phoneNumberSearchAttributes = []string{
+	"country_code",
+	"type",
+	"area_code",
+	"search",
+}
+
+// suppressPhoneNumberSearchChange hides changes to the purchase-time search arguments of a number that has already been
+// purchased, unless `replace_on_search_change` is set. Numbers that were imported or adopted were never searched for,
+// so setting their search arguments is always ignored.
+func suppressPhoneNumberSearchChange(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" || old == new {
+		return false
+	}
+	if old != "" && d.Get("replace_on_search_change").(bool) {
+		return false
+	}
+
+	log.WithFields(
+		log.Fields{
+			"phone_number_sid": d.Id(),
+			"old":              old,
+			"new":              new,
+		},
+	).Info(fmt.Sprintf("Ignoring change to %s after purchase", k))
+
+	return true
+}
+
+// suppressPurchasedPhoneNumberChange hides `phone_number` being set on an imported or adopted resource when it names the
+// number the resource already manages, so it is not replaced by a purchase of the number it already owns.
+func suppressPurchasedPhoneNumberChange(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && old == "" && new == d.Get("number").(string)
+}
+
+// forceNewOnPhoneNumberSearchChange replaces a purchased number whose search arguments changed when
+// `replace_on_search_change` is set.
+func forceNewOnPhoneNumberSearchChange(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("replace_on_search_change").(bool) {
+		return nil
+	}
+
+	for _, key := range phoneNumberSearchAttributes {
+		if d.HasChange(key) {
+			if err := d.ForceNew(key); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// phoneNumberRoutingBlock returns the single `sms`, `voice` or `emergency` block planned for a number, or nil when the
+// block is absent.
+func phoneNumberRoutingBlock(d *schema.ResourceDiff, key string) map[string]interface{} {
+	set, ok := d.Get(key).(*schema.Set)
+	if !ok || set.Len() == 0 {
+		return nil
+	}
+	block, _ := set.List()[0].(map[string]interface{})
+	return block
+}
+
+// validatePhoneNumberRouting fails the plan when routing settings that Twilio would silently override are set
+// together. Only blocks that are part of the diff are checked, so values read back from the API never fail a plan.
+func validatePhoneNumberRouting(d *schema.ResourceDiff, meta interface{}) error {
+	if voice := phoneNumberRoutingBlock(d, "voice"); voice != nil && d.HasChange("voice") {
+		if cast.ToString(voice["application_sid"]) != "" && cast.ToString(voice["primary_url"]) != "" {
+			return errors.New("'voice.application_sid' and 'voice.primary_url' cannot both be set: the application's voice URL overrides 'voice.primary_url'")
+		}
+	}
+
+	if sms := phoneNumberRoutingBlock(d, "sms"); sms != nil && d.HasChange("sms") {
+		if cast.ToString(sms["application_sid"]) != "" && cast.ToString(sms["primary_url"]) != "" {
+			return errors.New("'sms.application_sid' and 'sms.primary_url' cannot both be set: the application's SMS URL overrides 'sms.primary_url'")
+		}
+	}
+
+	if emergency := phoneNumberRoutingBlock(d, "emergency"); emergency != nil && d.HasChange("emergency") {
+		if strings.EqualFold(cast.ToString(emergency["status"]), "Active") && cast.ToString(emergency["address_sid"]) == "" {
+			return errors.New("'emergency.address_sid' must be set when 'emergency.status' is Active")
+		}
+	}
+
+	return nil
+}
+
+// validatePhoneNumberSource fails the plan of a new number when neither `country_code` nor `existing_number` is set,
+// since `country_code` is only required to purchase a number.
+func validatePhoneNumberSource(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" || !d.NewValueKnown("country_code") || !d.NewValueKnown("existing_number") || !d.NewValueKnown("phone_number") {
+		return nil
+	}
+	if d.Get("country_code").(string) == "" && d.Get("existing_number").(string) == "" && d.Get("phone_number").(string) == "" {
+		return errors.New("One of 'country_code' (to search for a number), 'phone_number' (to purchase an exact number) or 'existing_number' (to adopt one) must be specified")
+	}
+	return nil
+}
+
+// validatePhoneNumberAddressRequirements fails the plan of a number that is about to be purchased when numbers matching
+// its search require an address and neither `address_sid` nor `bundle_sid` is set.
+func validatePhoneNumberAddressRequirements(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" {
+		return nil
+	}
+
+	countryCode := d.Get("country_code").(string)
+	if countryCode == "" || !d.NewValueKnown("country_code") || !d.NewValueKnown("area_code") || !d.NewValueKnown("search") {
+		return nil
+	}
+	if existingNumber, ok := d.GetOk("existing_number"); ok && existingNumber.(string) != "" {
+		return nil
+	}
+	for _, key := range []string{"address_sid", "bundle_sid"} {
+		if v, ok := d.GetOk(key); ok && v.(string) != "" || !d.NewValueKnown(key) {
+			return nil
+		}
+	}
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	numType := d.Get("type").(string)
+
+	searchParams := make(url.Values)
+	addIfNotEmpty(searchParams, "AreaCode", d.Get("area_code"))
+	addIfNotEmpty(searchParams, "Contains", d.Get("search"))
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":  config.AccountSID,
+			"country_code": countryCode,
+		},
+	).Debug(fmt.Sprintf("START client.Available.Numbers.%s.GetPage", numType))
+
+	numbers, err := searchAvailablePhoneNumbers(context.TODO(), client, countryCode, numType, searchParams, 1)
+	if err != nil {
+		return fmt.Errorf("Encountered an error when checking the address requirements of numbers in %s: %s", countryCode, err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":  config.AccountSID,
+			"country_code": countryCode,
+			"result_count": len(numbers),
+		},
+	).Debug(fmt.Sprintf("END client.Available.Numbers.%s.GetPage", numType))
+
+	if len(numbers) > 0 && numbers[0].AddressRequirements != "" && numbers[0].AddressRequirements != "none" {
+		return fmt.Errorf("%s numbers in %s require a %s address (address_requirements = %q): set 'address_sid' or 'bundle_sid'", numType, countryCode, numbers[0].AddressRequirements, numbers[0].AddressRequirements)
+	}
+
+	return nil
+}
+
 func addIfNotEmpty(params url.Values, key string, value interface{}) {
 	s := cast.ToString(value)
 
@@ -277,32 +546,60 @@ func addIfNotEmpty(params url.Values, key string, value interface{}) {
 	}
 }
 
+// addOrClear behaves like addIfNotEmpty, but sends an empty value when clear is set so a removed setting is also
+// removed from Twilio.
+func addOrClear(params url.Values, key string, value interface{}, clear bool) {
+	s := cast.ToString(value)
+
+	if s != "" || clear {
+		params.Set(key, s)
+	}
+}
+
+// isCleared reports whether the attribute key of an existing resource changed, in which case an empty value has to be
+// sent to Twilio rather than left out.
+func isCleared(d *schema.ResourceData, key string) bool {
+	return !d.IsNewResource() && d.HasChange(key)
+}
+
 func makeCreateRequestPayload(d *schema.ResourceData) url.Values {
-	createRequestPayload := make(url.Values)
+	createRequestPayload := makeConfigurationRequestPayload(d)
 
 	addIfNotEmpty(createRequestPayload, "FriendlyName", d.Get("friendly_name"))
-	addIfNotEmpty(createRequestPayload, "AddressSid", d.Get("address_sid"))
-	addIfNotEmpty(createRequestPayload, "TrunkSid", d.Get("trunk_sid"))
-	addIfNotEmpty(createRequestPayload, "IdentitySid", d.Get("identity_sid"))
+	addOrClear(createRequestPayload, "AddressSid", d.Get("address_sid"), isCleared(d, "address_sid"))
+	addOrClear(createRequestPayload, "IdentitySid", d.Get("identity_sid"), isCleared(d, "identity_sid"))
+	addIfNotEmpty(createRequestPayload, "BundleSid", d.Get("bundle_sid"))
+
+	return createRequestPayload
+}
+
+// makeConfigurationRequestPayload only holds the routing settings shared with twilio_phone_number_configuration: the
+// trunk and the `sms`, `voice`, `status_callback` and `emergency` blocks.
+func makeConfigurationRequestPayload(d *schema.ResourceData) url.Values {
+	createRequestPayload := make(url.Values)
+
+	addOrClear(createRequestPayload, "TrunkSid", d.Get("trunk_sid"), isCleared(d, "trunk_sid"))
 
 	if sms := d.Get("sms").(*schema.Set); sms.Len() > 0 {
 		sms := sms.List()[0].(map[string]interface{})
+		clear := isCleared(d, "sms")
 
-		addIfNotEmpty(createRequestPayload, "SmsApplicationSid", sms["application_sid"])
-		addIfNotEmpty(createRequestPayload, "SmsFallbackUrl", sms["fallback_url"])
+		addOrClear(createRequestPayload, "SmsApplicationSid", sms["application_sid"], clear)
+		addOrClear(createRequestPayload, "SmsFallbackUrl", sms["fallback_url"], clear)
 		addIfNotEmpty(createRequestPayload, "SmsFallbackMethod", sms["fallback_http_method"])
 		addIfNotEmpty(createRequestPayload, "SmsMethod", sms["primary_http_method"])
-		addIfNotEmpty(createRequestPayload, "SmsUrl", sms["primary_url"])
+		addOrClear(createRequestPayload, "SmsUrl", sms["primary_url"], clear)
 	}
 
 	if voice := d.Get("voice").(*schema.Set); voice.Len() > 0 {
 		voice := voice.List()[0].(map[string]interface{})
+		clear := isCleared(d, "voice")
 
-		addIfNotEmpty(createRequestPayload, "VoiceApplicationSid", voice["application_sid"])
-		addIfNotEmpty(createRequestPayload, "VoiceFallbackUrl", voice["fallback_url"])
+		addOrClear(createRequestPayload, "VoiceApplicationSid", voice["application_sid"], clear)
+		addOrClear(createRequestPayload, "VoiceFallbackUrl", voice["fallback_url"], clear)
 		addIfNotEmpty(createRequestPayload, "VoiceFallbackMethod", voice["fallback_http_method"]) // TODO Map to safe values
 		addIfNotEmpty(createRequestPayload, "VoiceMethod", voice["primary_http_method"])          // TODO Map to safe values
-		addIfNotEmpty(createRequestPayload, "VoiceUrl", voice["primary_url"])
+		addOrClear(createRequestPayload, "VoiceUrl", voice["primary_url"], clear)
 		addIfNotEmpty(createRequestPayload, "VoiceCallerIdLookup", voice["caller_id_enabled"])
 		addIfNotEmpty(createRequestPayload, "VoiceReceiveMode", voice["receive_mode"]) // TODO Map to Twilio
 	}
@@ -311,19 +608,48 @@ func makeCreateRequestPayload(d *schema.ResourceData) url.Values {
 		statusCallback := statusCallback.List()[0].(map[string]interface{})
 
 		addIfNotEmpty(createRequestPayload, "StatusCallbackMethod", statusCallback["http_method"]) // TODO Map to safe values
-		addIfNotEmpty(createRequestPayload, "StatusCallback", statusCallback["url"])
+		addOrClear(createRequestPayload, "StatusCallback", statusCallback["url"], isCleared(d, "status_callback"))
 	}
 
 	if emergency := d.Get("emergency").(*schema.Set); emergency.Len() > 0 {
 		emergency := emergency.List()[0].(map[string]interface{})
 
 		addIfNotEmpty(createRequestPayload, "EmergencyStatus", emergency["status"]) // TODO Map to Twilio values
-		addIfNotEmpty(createRequestPayload, "EmergencyAddressSid", emergency["address_sid"])
+		addOrClear(createRequestPayload, "EmergencyAddressSid", emergency["address_sid"], isCleared(d, "emergency"))
 	}
 
 	return createRequestPayload
 }
 
+// makeDetachRequestPayload clears every webhook and application of a number without releasing it.
+func makeDetachRequestPayload() url.Values {
+	detachRequestPayload := make(url.Values)
+
+	for _, key := range []string{
+		"SmsApplicationSid",
+		"SmsFallbackUrl",
+		"SmsUrl",
+		"VoiceApplicationSid",
+		"VoiceFallbackUrl",
+		"VoiceUrl",
+		"StatusCallback",
+	} {
+		detachRequestPayload.Set(key, "")
+	}
+
+	return detachRequestPayload
+}
+
+// makeParkRequestPayload strips a number of its webhooks, applications and trunk and renames it.
+func makeParkRequestPayload(friendlyName string) url.Values {
+	parkRequestPayload := makeDetachRequestPayload()
+
+	parkRequestPayload.Set("TrunkSid", "")
+	parkRequestPayload.Set("FriendlyName", friendlyName)
+
+	return parkRequestPayload
+}
+
 func hashAnything(item interface{}) int {
 	s := cast.ToString(item)
 	return hashcode.String(s)
@@ -341,41 +667,69 @@ func hashStringKeyedMap(v interface{}) int {
 	return hashcode.String(buf.String())
 }
 
-func mapTwilioPhoneNumberToTerraform(ph *twilio.IncomingPhoneNumber, d *schema.ResourceData) error {
+func mapTwilioPhoneNumberToTerraform(ph *incomingPhoneNumber, d *schema.ResourceData) error {
 	err := d.Set("sid", ph.Sid)
+	if err == nil {
+		err = d.Set("account_sid", ph.AccountSid)
+	}
 	if err == nil {
 		err = d.Set("number", string(ph.PhoneNumber))
 	}
 	if err == nil {
 		err = d.Set("friendly_name", ph.FriendlyName)
 	}
-	// d.Set("address_sid", p.AddressSid) -- address SID not in twiliogo
-	// d.Set("identity_sid", p.IdentitySid) -- identity SID not in twiliogo
 	if err == nil {
-		err = d.Set("trunk_sid", ph.TrunkSid)
+		err = d.Set("address_sid", ph.AddressSid)
+	}
+	if err == nil {
+		err = d.Set("identity_sid", ph.IdentitySid)
+	}
+	if err == nil {
+		err = d.Set("bundle_sid", ph.BundleSid)
 	}
 	if err == nil && ph.DateCreated.Valid {
 		err = d.Set("date_created", ph.DateCreated.Time.Format("2006-01-02T15:04:05-07:00"))
 	}
-	if ph.DateUpdated.Valid {
+	if err == nil && ph.DateUpdated.Valid {
 		err = d.Set("date_updated", ph.DateUpdated.Time.Format("2006-01-02T15:04:05-07:00"))
 	}
 	if err == nil {
 		err = d.Set("address_requirements", ph.AddressRequirements)
 	}
 	if err == nil {
-		err = d.Set("is_beta", ph.Beta)
+		err = d.Set("emergency_address_status", ph.EmergencyAddressStatus)
 	}
 	if err == nil {
-		err = d.Set("is_mms_capable", ph.Capabilities.MMS)
+		err = d.Set("origin", ph.Origin)
 	}
 	if err == nil {
-		err = d.Set("is_sms_capable", ph.Capabilities.SMS)
+		err = d.Set("status", ph.Status)
+	}
+	if err == nil {
+		err = d.Set("is_beta", ph.Beta)
+	}
+	if err == nil && ph.Capabilities != nil {
+		err = d.Set("is_mms_capable", ph.Capabilities.MMS)
+		if err == nil {
+			err = d.Set("is_sms_capable", ph.Capabilities.SMS)
+		}
+		if err == nil {
+			err = d.Set("is_voice_capable", ph.Capabilities.Voice)
+		}
+		if err == nil {
+			err = d.Set("is_fax_capable", ph.Capabilities.Fax)
+		}
 	}
 	if err == nil {
-		err = d.Set("is_voice_capable", ph.Capabilities.Voice)
+		err = mapTwilioPhoneNumberConfigurationToTerraform(ph, d)
 	}
-	// d.Set("is_fax_capable", p.Capabilities.Fax) -- p.Capabilities.Fax not in twiliogo
+	return err
+}
+
+// mapTwilioPhoneNumberConfigurationToTerraform maps the routing settings of a number: its trunk, voice, SMS, status
+// callback and emergency configuration.
+func mapTwilioPhoneNumberConfigurationToTerraform(ph *incomingPhoneNumber, d *schema.ResourceData) error {
+	err := d.Set("trunk_sid", ph.TrunkSid.String)
 
 	// Voice set
 	if err == nil {
@@ -386,7 +740,7 @@ func mapTwilioPhoneNumberToTerraform(ph *twilio.IncomingPhoneNumber, d *schema.R
 		voiceMap["primary_url"] = ph.VoiceURL
 		voiceMap["primary_http_method"] = ph.VoiceMethod
 		voiceMap["caller_id_enabled"] = ph.VoiceCallerIDLookup
-		// voiceMap["receive_mode"] = p.ReceiveMode -- receive mode not in twiliogo
+		voiceMap["receive_mode"] = ph.VoiceReceiveMode
 		err = d.Set("voice", []map[string]interface{}{voiceMap})
 	}
 	if err == nil {
@@ -402,8 +756,8 @@ func mapTwilioPhoneNumberToTerraform(ph *twilio.IncomingPhoneNumber, d *schema.R
 	if err == nil {
 		// status_callback
 		statusCallbackMap := make(map[string]interface{})
-		statusCallbackMap["url"] = ph.SMSFallbackURL
-		statusCallbackMap["http_method"] = ph.SMSFallbackMethod
+		statusCallbackMap["url"] = ph.StatusCallback
+		statusCallbackMap["http_method"] = ph.StatusCallbackMethod
 		err = d.Set("status_callback", []map[string]interface{}{statusCallbackMap})
 	}
 	if err == nil {
@@ -418,30 +772,92 @@ func mapTwilioPhoneNumberToTerraform(ph *twilio.IncomingPhoneNumber, d *schema.R
 	return err
 }
 
-func resourceTwilioPhoneNumberCreate(d *schema.ResourceData, meta interface{}) error {
-	log.Debug("ENTER resourceTwilioPhoneNumberCreate")
+// resourceTwilioPhoneNumberImport accepts a phone number SID, an E.164 number (`+15551234567`) or
+// `friendly_name:<name>` and resolves the latter two to the SID of the single matching number. Numbers owned by a
+// subaccount are imported by prefixing any of these with the subaccount SID, e.g. `ACXXXX/+15551234567`.
+func resourceTwilioPhoneNumberImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	log.Debug("ENTER resourceTwilioPhoneNumberImport")
 
 	client := meta.(*TerraformTwilioContext).client
 	config := meta.(*TerraformTwilioContext).configuration
 	ctx := context.TODO()
 
-	// Required parameter
-	countryCode := d.Get("country_code").(string)
-	numType := d.Get("type").(string)
+	importID := d.Id()
 
-	//var searchParams url.Values
-	searchParams := make(url.Values)
+	accountSid := ""
+	if parts := strings.SplitN(importID, "/", 2); len(parts) == 2 && strings.HasPrefix(parts[0], "AC") {
+		accountSid, importID = parts[0], parts[1]
+		d.SetId(importID)
+		if err := d.Set("account_sid", accountSid); err != nil {
+			return nil, err
+		}
+	}
 
-	serviceSid := cast.ToString(d.Get("service_sid"))
-	areaCode := d.Get("area_code").(string)
-	if len(areaCode) > 0 {
-		searchParams.Set("AreaCode", areaCode)
+	query := make(url.Values)
+	var matches func(*incomingPhoneNumber) bool
+	if strings.HasPrefix(importID, "+") {
+		query.Set("PhoneNumber", importID)
+		matches = func(ph *incomingPhoneNumber) bool {
+			return string(ph.PhoneNumber) == importID
+		}
+	} else if strings.HasPrefix(importID, "friendly_name:") {
+		friendlyName := strings.TrimPrefix(importID, "friendly_name:")
+		query.Set("FriendlyName", friendlyName)
+		matches = func(ph *incomingPhoneNumber) bool {
+			return ph.FriendlyName == friendlyName
+		}
+	} else {
+		return []*schema.ResourceData{d}, nil
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"import_id":   importID,
+		},
+	).Debug("START client.IncomingNumbers.GetPageIterator")
+
+	numbers, err := listIncomingPhoneNumbers(ctx, client, accountSid, query)
+	if err != nil {
+		return nil, fmt.Errorf("Encountered an error when looking up phone number %s: %s", importID, err)
+	}
+
+	var found []*incomingPhoneNumber
+	for _, ph := range numbers {
+		if matches(ph) {
+			found = append(found, ph)
+		}
 	}
 
-	search := d.Get("search").(string)
-	if len(search) > 0 {
-		searchParams.Set("Contains", search)
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"import_id":   importID,
+			"match_count": len(found),
+		},
+	).Debug("END client.IncomingNumbers.GetPageIterator")
+
+	if len(found) == 0 {
+		return nil, fmt.Errorf("No phone number found matching %s", importID)
+	}
+	if len(found) > 1 {
+		sids := make([]string, 0, len(found))
+		for _, ph := range found {
+			sids = append(sids, ph.Sid)
+		}
+		return nil, ambiguousMatchError("phone numbers", importID, sids)
 	}
+
+	d.SetId(found[0].Sid)
+	return []*schema.ResourceData{d}, nil
+}
+
+// purchaseTwilioPhoneNumber buys the first available number matching the search parameters and configures it with
+// the given payload.
+func purchaseTwilioPhoneNumber(ctx context.Context, meta interface{}, accountSid string, countryCode string, numType string, searchParams url.Values, buyParams url.Values) (*incomingPhoneNumber, error) {
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
 	log.WithFields(
 		log.Fields{
 			"account_sid":  config.AccountSID,
@@ -449,15 +865,7 @@ func resourceTwilioPhoneNumberCreate(d *schema.ResourceData, meta interface{}) e
 		},
 	).Debug(fmt.Sprintf("START client.Available.Numbers.%s.GetPage", numType))
 
-	var searchResult *twilio.AvailableNumberPage
-	var err error
-	if numType == "Mobile" {
-		searchResult, err = client.AvailableNumbers.Mobile.GetPage(ctx, countryCode, searchParams)
-	} else if numType == "TollFree" {
-		searchResult, err = client.AvailableNumbers.TollFree.GetPage(ctx, countryCode, searchParams)
-	} else {
-		searchResult, err = client.AvailableNumbers.Local.GetPage(ctx, countryCode, searchParams)
-	}
+	numbers, err := searchAvailablePhoneNumbers(ctx, client, countryCode, numType, searchParams, 1)
 	if err != nil {
 		log.WithFields(
 			log.Fields{
@@ -466,19 +874,19 @@ func resourceTwilioPhoneNumberCreate(d *schema.ResourceData, meta interface{}) e
 			},
 		).Error("Caught an unexpected error when searching for phone numbers")
 
-		return err
+		return nil, err
 	}
 
 	log.WithFields(
 		log.Fields{
 			"account_sid":  config.AccountSID,
 			"country_code": countryCode,
-			"search":       search,
-			"result_count": len(searchResult.Numbers),
+			"search":       searchParams.Get("Contains"),
+			"result_count": len(numbers),
 		},
 	).Debug(fmt.Sprintf("END client.Available.Numbers.%s.GetPage", numType))
 
-	if searchResult != nil && len(searchResult.Numbers) == 0 {
+	if len(numbers) == 0 {
 		log.WithFields(
 			log.Fields{
 				"account_sid":  config.AccountSID,
@@ -486,15 +894,23 @@ func resourceTwilioPhoneNumberCreate(d *schema.ResourceData, meta interface{}) e
 			},
 		).Error("No phone numbers matched the search patterns")
 
-		return errors.New("No numbers found that match your search")
+		return nil, errors.New("No numbers found that match your search")
 	}
 
 	// Grab the first number that matches
-	number := searchResult.Numbers[0]
-	e164Number := string(number.PhoneNumber)
+	return buyTwilioPhoneNumber(ctx, meta, accountSid, string(numbers[0].PhoneNumber), buyParams)
+}
 
-	buyParams := makeCreateRequestPayload(d)
-	buyParams.Set("PhoneNumber", e164Number)
+// buyTwilioPhoneNumber purchases the given number into accountSid and configures it with the given payload.
+func buyTwilioPhoneNumber(ctx context.Context, meta interface{}, accountSid string, e164Number string, buyParams url.Values) (*incomingPhoneNumber, error) {
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	params := make(url.Values)
+	for key, values := range buyParams {
+		params[key] = values
+	}
+	params.Set("PhoneNumber", e164Number)
 
 	log.WithFields(
 		log.Fields{
@@ -503,7 +919,7 @@ func resourceTwilioPhoneNumberCreate(d *schema.ResourceData, meta interface{}) e
 		},
 	).Debug("START client.IncomingNumbers.Create")
 
-	buyResult, err := client.IncomingNumbers.Create(ctx, buyParams)
+	buyResult, err := createIncomingPhoneNumber(ctx, client, accountSid, params)
 
 	if err != nil {
 		log.WithFields(
@@ -513,42 +929,136 @@ func resourceTwilioPhoneNumberCreate(d *schema.ResourceData, meta interface{}) e
 			},
 		).Error("Caught an error when attempting to purchase phone number: " + err.Error())
 
-		return err
+		return nil, err
 	}
 
-	d.SetId(buyResult.Sid)
-	d.Set("number", e164Number)
+	log.WithFields(
+		log.Fields{
+			"account_sid":      config.AccountSID,
+			"phone_number":     e164Number,
+			"phone_number_sid": buyResult.Sid,
+		},
+	).Debug("END client.IncomingNumbers.Create")
+
+	return buyResult, nil
+}
+
+// adoptTwilioPhoneNumber takes over an already-owned number, identified by its E.164 representation, and applies the
+// given configuration payload to it instead of purchasing a new number.
+func adoptTwilioPhoneNumber(ctx context.Context, meta interface{}, accountSid string, e164Number string, updateParams url.Values) (*incomingPhoneNumber, error) {
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
 
-	err = mapTwilioPhoneNumberToTerraform(buyResult, d)
+	log.WithFields(
+		log.Fields{
+			"account_sid":  config.AccountSID,
+			"phone_number": e164Number,
+		},
+	).Debug("START client.IncomingNumbers.GetPageIterator")
 
+	numbers, err := listIncomingPhoneNumbers(ctx, client, accountSid, url.Values{"PhoneNumber": []string{e164Number}})
 	if err != nil {
-		return fmt.Errorf("Encountered error while reading buy result for phone number SID %s and mapping it to TF: %s", buyResult.Sid, err)
+		return nil, fmt.Errorf("Encountered an error when looking up existing phone number %s: %s", e164Number, err)
+	}
+
+	var existing *incomingPhoneNumber
+	for _, ph := range numbers {
+		if string(ph.PhoneNumber) == e164Number {
+			existing = ph
+			break
+		}
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":  config.AccountSID,
+			"phone_number": e164Number,
+		},
+	).Debug("END client.IncomingNumbers.GetPageIterator")
+
+	if existing == nil {
+		if accountSid == "" {
+			accountSid = config.AccountSID
+		}
+		return nil, fmt.Errorf("Phone number %s is not owned by account %s and cannot be adopted", e164Number, accountSid)
 	}
 
 	log.WithFields(
 		log.Fields{
 			"account_sid":      config.AccountSID,
 			"phone_number":     e164Number,
-			"phone_number_sid": buyResult.Sid,
+			"phone_number_sid": existing.Sid,
 		},
-	).Debug("END client.IncomingNumbers.Create")
+	).Debug("START client.IncomingNumbers.Update")
+
+	result, err := updateIncomingPhoneNumber(ctx, client, accountSid, existing.Sid, updateParams)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to configure adopted phone number SID %s: %s", existing.Sid, err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":      config.AccountSID,
+			"phone_number":     e164Number,
+			"phone_number_sid": existing.Sid,
+		},
+	).Debug("END client.IncomingNumbers.Update")
+
+	return result, nil
+}
+
+func resourceTwilioPhoneNumberCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioPhoneNumberCreate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	ctx := context.TODO()
+
+	serviceSid := cast.ToString(d.Get("service_sid"))
+	accountSid := d.Get("account_sid").(string)
+	existingNumber := d.Get("existing_number").(string)
+	phoneNumber := d.Get("phone_number").(string)
+	countryCode := d.Get("country_code").(string)
+
+	var result *incomingPhoneNumber
+	var err error
+	if len(existingNumber) > 0 {
+		result, err = adoptTwilioPhoneNumber(ctx, meta, accountSid, existingNumber, makeCreateRequestPayload(d))
+	} else if len(phoneNumber) > 0 {
+		result, err = buyTwilioPhoneNumber(ctx, meta, accountSid, phoneNumber, makeCreateRequestPayload(d))
+	} else if len(countryCode) > 0 {
+		result, err = purchaseTwilioPhoneNumber(ctx, meta, accountSid, countryCode, d.Get("type").(string), makeAvailableNumberSearchParams(d), makeCreateRequestPayload(d))
+	} else {
+		err = errors.New("One of 'country_code' (to search for a number), 'phone_number' (to purchase an exact number) or 'existing_number' (to adopt one) must be specified")
+	}
+	if err != nil {
+		return err
+	}
+
+	d.SetId(result.Sid)
+
+	err = mapTwilioPhoneNumberToTerraform(result, d)
+
+	if err != nil {
+		return fmt.Errorf("Encountered error while reading result for phone number SID %s and mapping it to TF: %s", result.Sid, err)
+	}
 
 	if len(serviceSid) > 0 {
 		log.WithFields(
 			log.Fields{
 				"account_sid": config.AccountSID,
-				"phone_sid":   buyResult.Sid,
+				"phone_sid":   result.Sid,
 				"service_sid": serviceSid,
 			},
 		).Debug("START client.Message.Services.CreatePhoneNumber")
-		_, err := client.Message.Services.CreatePhoneNumber(ctx, serviceSid, buyResult.Sid)
-		if err != nil {
-			return fmt.Errorf("Encountered error adding phone number with SID %s to messaging service with SID %s: %s", buyResult.Sid, serviceSid, err)
+		_, err := client.Message.Services.CreatePhoneNumber(ctx, serviceSid, result.Sid)
+		if err != nil && !strings.Contains(err.Error(), "already in the Messaging Service") {
+			return fmt.Errorf("Encountered error adding phone number with SID %s to messaging service with SID %s: %s", result.Sid, serviceSid, err)
 		}
 		log.WithFields(
 			log.Fields{
 				"account_sid": config.AccountSID,
-				"phone_sid":   buyResult.Sid,
+				"phone_sid":   result.Sid,
 				"service_sid": serviceSid,
 			},
 		).Debug("END client.Message.Services.CreatePhoneNumber")
@@ -580,7 +1090,7 @@ func resourceTwilioPhoneNumberRead(d *schema.ResourceData, meta interface{}) err
 		},
 	).Debug("START client.IncomingNumbers.Get")
 
-	ph, err := client.IncomingNumbers.Get(ctx, sid)
+	ph, err := getIncomingPhoneNumber(ctx, client, d.Get("account_sid").(string), sid)
 
 	if err != nil {
 		return fmt.Errorf("Encountered an error when getting phone number SID %s: %s", sid, err)
@@ -603,10 +1113,37 @@ func resourceTwilioPhoneNumberUpdate(d *schema.ResourceData, meta interface{}) e
 
 	sid := d.Id()
 
-	updatePayload := makeCreateRequestPayload(d)
+	if d.HasChange("account_sid") {
+		before, after := d.GetChange("account_sid")
+		accountSidBefore := cast.ToString(before)
+		accountSidAfter := cast.ToString(after)
+		if accountSidAfter == "" {
+			accountSidAfter = config.AccountSID
+		}
+
+		log.WithFields(
+			log.Fields{
+				"account_sid":      accountSidBefore,
+				"phone_sid":        sid,
+				"transfer_account": accountSidAfter,
+			},
+		).Debug("START client.IncomingNumbers.Update")
+
+		_, err := updateIncomingPhoneNumber(ctx, client, accountSidBefore, sid, url.Values{"AccountSid": []string{accountSidAfter}})
+		if err != nil {
+			return fmt.Errorf("Failed to transfer phone number SID %s to account %s: %s", sid, accountSidAfter, err)
+		}
+
+		log.WithFields(
+			log.Fields{
+				"account_sid":      accountSidBefore,
+				"phone_sid":        sid,
+				"transfer_account": accountSidAfter,
+			},
+		).Debug("END client.IncomingNumbers.Update")
+	}
 
-	//phoneNumber := d.Get("number").(string)
-	//updatePayload.Set("PhoneNumber", e164Number)
+	updatePayload := makeCreateRequestPayload(d)
 
 	log.WithFields(
 		log.Fields{
@@ -615,7 +1152,7 @@ func resourceTwilioPhoneNumberUpdate(d *schema.ResourceData, meta interface{}) e
 		},
 	).Debug("START client.IncomingNumbers.Update")
 
-	_, err := client.IncomingNumbers.Update(ctx, sid, updatePayload)
+	_, err := updateIncomingPhoneNumber(ctx, client, d.Get("account_sid").(string), sid, updatePayload)
 
 	if err != nil {
 		return fmt.Errorf("Failed to update phone number SID %s: %s", sid, err)
@@ -688,30 +1225,58 @@ func resourceTwilioPhoneNumberDelete(d *schema.ResourceData, meta interface{}) e
 	ctx := context.TODO()
 
 	sid := d.Id()
+	accountSid := d.Get("account_sid").(string)
 	phoneNumber := d.Get("number").(string)
-	serviceId := cast.ToString(d.Get("service_id"))
+	serviceSid := cast.ToString(d.Get("service_sid"))
+
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Phone number %s (SID %s) has deletion_protection enabled, set it to false and apply before destroying", phoneNumber, sid)
+	}
+
+	onDestroy := phoneNumberOnDestroy(d)
 
-	if len(serviceId) > 0 {
+	serviceSids := []string{}
+	if onDestroy == "release" {
+		// Releasing the number drops every other membership along with it
+		if len(serviceSid) > 0 {
+			serviceSids = append(serviceSids, serviceSid)
+		}
+	} else {
+		// A kept number has to leave every messaging service, including ones it joined outside of service_sid
+		var err error
+		if serviceSids, err = listPhoneNumberMessagingServiceSids(ctx, client, sid); err != nil {
+			return fmt.Errorf("Encountered error listing the messaging services of phone number with SID %s: %s", sid, err)
+		}
+	}
+
+	for _, serviceSid := range serviceSids {
 		log.WithFields(
 			log.Fields{
 				"account_sid": config.AccountSID,
 				"phone_sid":   sid,
-				"service_sid": serviceId,
+				"service_sid": serviceSid,
 			},
 		).Debug("START client.Message.Services.DeletePhoneNumber")
-		err := client.Message.Services.DeletePhoneNumber(ctx, serviceId, sid)
-		if err != nil {
-			return fmt.Errorf("Encountered error removing phone number with SID %s from messaging service with SID %s: %s", sid, serviceId, err)
+		err := client.Message.Services.DeletePhoneNumber(ctx, serviceSid, sid)
+		if err != nil && !isNotFoundError(err) {
+			return fmt.Errorf("Encountered error removing phone number with SID %s from messaging service with SID %s: %s", sid, serviceSid, err)
 		}
 		log.WithFields(
 			log.Fields{
 				"account_sid": config.AccountSID,
 				"phone_sid":   sid,
-				"service_sid": serviceId,
+				"service_sid": serviceSid,
 			},
 		).Debug("END client.Message.Services.DeletePhoneNumber")
 	}
 
+	switch onDestroy {
+	case "detach":
+		return updateTwilioPhoneNumberOnDestroy(ctx, meta, accountSid, sid, phoneNumber, makeDetachRequestPayload())
+	case "park":
+		return updateTwilioPhoneNumberOnDestroy(ctx, meta, accountSid, sid, phoneNumber, makeParkRequestPayload(d.Get("park_friendly_name").(string)))
+	}
+
 	log.WithFields(
 		log.Fields{
 			"account_sid":      config.AccountSID,
@@ -720,7 +1285,7 @@ func resourceTwilioPhoneNumberDelete(d *schema.ResourceData, meta interface{}) e
 		},
 	).Debug("START client.IncomingNumbers.Release")
 
-	err := client.IncomingNumbers.Release(ctx, sid)
+	err := releaseIncomingPhoneNumber(ctx, client, accountSid, sid)
 
 	log.WithFields(
 		log.Fields{
@@ -736,3 +1301,71 @@ func resourceTwilioPhoneNumberDelete(d *schema.ResourceData, meta interface{}) e
 
 	return nil
 }
+
+// phoneNumberOnDestroy resolves what destroy does with the number from `on_destroy`, the deprecated
+// `release_on_destroy` and whether the number was adopted rather than purchased.
+func phoneNumberOnDestroy(d *schema.ResourceData) string {
+	if onDestroy := d.Get("on_destroy").(string); onDestroy != "" {
+		return onDestroy
+	}
+	if release, ok := d.GetOkExists("release_on_destroy"); ok {
+		if release.(bool) {
+			return "release"
+		}
+		return "detach"
+	}
+	if d.Get("existing_number").(string) != "" {
+		return "detach"
+	}
+	return "release"
+}
+
+// listPhoneNumberMessagingServiceSids returns the SIDs of every messaging service whose sender pool holds the number.
+func listPhoneNumberMessagingServiceSids(ctx context.Context, client *twilio.Client, sid string) ([]string, error) {
+	services, err := listMessagingServices(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	serviceSids := make([]string, 0)
+	for _, service := range services {
+		phoneNumberSids, err := listMessagingServicePhoneNumberSids(ctx, client, service.Sid)
+		if err != nil {
+			return nil, err
+		}
+		if phoneNumberSids[sid] {
+			serviceSids = append(serviceSids, service.Sid)
+		}
+	}
+	return serviceSids, nil
+}
+
+// updateTwilioPhoneNumberOnDestroy applies the given payload to a number that is kept by the account on destroy.
+func updateTwilioPhoneNumberOnDestroy(ctx context.Context, meta interface{}, accountSid string, sid string, phoneNumber string, payload url.Values) error {
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":      config.AccountSID,
+			"phone_number":     phoneNumber,
+			"phone_number_sid": sid,
+		},
+	).Debug("START client.IncomingNumbers.Update")
+
+	_, err := updateIncomingPhoneNumber(ctx, client, accountSid, sid, payload)
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":      config.AccountSID,
+			"phone_number":     phoneNumber,
+			"phone_number_sid": sid,
+		},
+	).Debug("END client.IncomingNumbers.Update")
+
+	if err != nil {
+		return fmt.Errorf("Failed to clear configuration of phone number SID %s: %s", sid, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,211 @@
+package twilio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// phoneNumberConfigurationAttributes lists the twilio_phone_number arguments managed by
+// twilio_phone_number_configuration.
+var phoneNumberConfigurationAttributes = []string{
+	"trunk_sid",
+	"sms",
+	"voice",
+	"status_callback",
+	"emergency",
+}
+
+func resourceTwilioPhoneNumberConfiguration() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"phone_number_sid": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ConflictsWith: []string{"phone_number"},
+			Description:   "SID of the already-owned phone number to configure. Either this or `phone_number` must be set.",
+		},
+		"phone_number": {
+			Type:          schema.TypeString,
+			Optional:      true,
+			Computed:      true,
+			ForceNew:      true,
+			ConflictsWith: []string{"phone_number_sid"},
+			Description:   "E.164 representation of the already-owned phone number to configure. Either this or `phone_number_sid` must be set.",
+		},
+		"account_sid": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "SID of the account (or subaccount) that owns the number. Defaults to the provider's account.",
+		},
+	}
+
+	phoneNumberSchema := resourceTwilioPhoneNumber().Schema
+	for _, key := range phoneNumberConfigurationAttributes {
+		s[key] = phoneNumberSchema[key]
+	}
+
+	return &schema.Resource{
+		Create: resourceTwilioPhoneNumberConfigurationCreate,
+		Read:   resourceTwilioPhoneNumberConfigurationRead,
+		Update: resourceTwilioPhoneNumberConfigurationUpdate,
+		Delete: resourceTwilioPhoneNumberConfigurationDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTwilioPhoneNumberImport,
+		},
+		CustomizeDiff: validatePhoneNumberRouting,
+
+		Schema: s,
+	}
+}
+
+func mapTwilioPhoneNumberConfigurationResourceToTerraform(ph *incomingPhoneNumber, d *schema.ResourceData) error {
+	err := d.Set("phone_number_sid", ph.Sid)
+	if err == nil {
+		err = d.Set("phone_number", string(ph.PhoneNumber))
+	}
+	if err == nil {
+		err = d.Set("account_sid", ph.AccountSid)
+	}
+	if err == nil {
+		err = mapTwilioPhoneNumberConfigurationToTerraform(ph, d)
+	}
+	return err
+}
+
+func resourceTwilioPhoneNumberConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioPhoneNumberConfigurationCreate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	ctx := context.TODO()
+
+	accountSid := d.Get("account_sid").(string)
+	sid := d.Get("phone_number_sid").(string)
+	phoneNumber := d.Get("phone_number").(string)
+
+	payload := makeConfigurationRequestPayload(d)
+
+	var result *incomingPhoneNumber
+	var err error
+	if len(sid) > 0 {
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"phone_sid":   sid,
+			},
+		).Debug("START client.IncomingNumbers.Update")
+
+		result, err = updateIncomingPhoneNumber(ctx, client, accountSid, sid, payload)
+		if err != nil {
+			return fmt.Errorf("Failed to configure phone number SID %s: %s", sid, err)
+		}
+
+		log.WithFields(
+			log.Fields{
+				"account_sid": config.AccountSID,
+				"phone_sid":   sid,
+			},
+		).Debug("END client.IncomingNumbers.Update")
+	} else if len(phoneNumber) > 0 {
+		result, err = adoptTwilioPhoneNumber(ctx, meta, accountSid, phoneNumber, payload)
+		if err != nil {
+			return err
+		}
+	} else {
+		return errors.New("Either 'phone_number_sid' or 'phone_number' must be specified")
+	}
+
+	d.SetId(result.Sid)
+
+	if err := mapTwilioPhoneNumberConfigurationResourceToTerraform(result, d); err != nil {
+		return fmt.Errorf("Encountered error while reading result for phone number SID %s and mapping it to TF: %s", result.Sid, err)
+	}
+
+	return nil
+}
+
+func resourceTwilioPhoneNumberConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioPhoneNumberConfigurationRead")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	ctx := context.TODO()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":      config.AccountSID,
+			"phone_number_sid": sid,
+		},
+	).Debug("START client.IncomingNumbers.Get")
+
+	ph, err := getIncomingPhoneNumber(ctx, client, d.Get("account_sid").(string), sid)
+	if err != nil {
+		return fmt.Errorf("Encountered an error when getting phone number SID %s: %s", sid, err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":      config.AccountSID,
+			"phone_number_sid": sid,
+		},
+	).Debug("END client.IncomingNumbers.Get")
+
+	if err := mapTwilioPhoneNumberConfigurationResourceToTerraform(ph, d); err != nil {
+		return fmt.Errorf("Encountered an error while mapping Twilio API result to terraform: %s", err)
+	}
+	return nil
+}
+
+func resourceTwilioPhoneNumberConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioPhoneNumberConfigurationUpdate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	ctx := context.TODO()
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"phone_sid":   sid,
+		},
+	).Debug("START client.IncomingNumbers.Update")
+
+	ph, err := updateIncomingPhoneNumber(ctx, client, d.Get("account_sid").(string), sid, makeConfigurationRequestPayload(d))
+	if err != nil {
+		return fmt.Errorf("Failed to update phone number SID %s: %s", sid, err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid": config.AccountSID,
+			"phone_sid":   sid,
+		},
+	).Debug("END client.IncomingNumbers.Update")
+
+	return mapTwilioPhoneNumberConfigurationResourceToTerraform(ph, d)
+}
+
+// resourceTwilioPhoneNumberConfigurationDelete clears the routing settings managed by this resource, including the
+// emergency address. The number itself is never released.
+func resourceTwilioPhoneNumberConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioPhoneNumberConfigurationDelete")
+
+	payload := makeDetachRequestPayload()
+	payload.Set("TrunkSid", "")
+	payload.Set("EmergencyAddressSid", "")
+	payload.Set("EmergencyStatus", "Inactive")
+
+	return updateTwilioPhoneNumberOnDestroy(context.TODO(), meta, d.Get("account_sid").(string), d.Id(), d.Get("phone_number").(string), payload)
+}
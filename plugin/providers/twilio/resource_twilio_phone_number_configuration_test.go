@@ -0,0 +1,120 @@
+package twilio
+
+import (
+	"encoding/json"
+	"net/url"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Phone number configuration", func() {
+	var ph *incomingPhoneNumber
+
+	BeforeEach(func() {
+		ph = new(incomingPhoneNumber)
+		Expect(json.Unmarshal([]byte(`{
+			"sid": "PN123",
+			"account_sid": "AC123",
+			"phone_number": "+15551234567",
+			"trunk_sid": null,
+			"voice_url": "https://example.com/voice",
+			"voice_method": "POST",
+			"voice_fallback_url": "https://example.com/voice-fallback",
+			"voice_fallback_method": "GET",
+			"voice_application_sid": "",
+			"voice_caller_id_lookup": true,
+			"voice_receive_mode": "voice",
+			"sms_url": "https://example.com/sms",
+			"sms_method": "POST",
+			"sms_fallback_url": "",
+			"sms_fallback_method": "POST",
+			"sms_application_sid": "",
+			"status_callback": "https://example.com/status",
+			"status_callback_method": "POST",
+			"emergency_address_sid": "AD123",
+			"emergency_status": "Active"
+		}`), ph)).To(Succeed())
+	})
+
+	It("sends back the settings it reads", func() {
+		d := resourceTwilioPhoneNumberConfiguration().Data(nil)
+		Expect(mapTwilioPhoneNumberConfigurationToTerraform(ph, d)).To(Succeed())
+
+		Expect(makeConfigurationRequestPayload(d)).To(Equal(url.Values{
+			"VoiceUrl":             {"https://example.com/voice"},
+			"VoiceMethod":          {"POST"},
+			"VoiceFallbackUrl":     {"https://example.com/voice-fallback"},
+			"VoiceFallbackMethod":  {"GET"},
+			"VoiceCallerIdLookup":  {"true"},
+			"VoiceReceiveMode":     {"voice"},
+			"SmsUrl":               {"https://example.com/sms"},
+			"SmsMethod":            {"POST"},
+			"SmsFallbackMethod":    {"POST"},
+			"StatusCallback":       {"https://example.com/status"},
+			"StatusCallbackMethod": {"POST"},
+			"EmergencyAddressSid":  {"AD123"},
+			"EmergencyStatus":      {"Active"},
+		}))
+	})
+
+	It("plans no changes when the configuration matches what was read", func() {
+		r := resourceTwilioPhoneNumberConfiguration()
+		d := r.Data(nil)
+		Expect(mapTwilioPhoneNumberConfigurationToTerraform(ph, d)).To(Succeed())
+
+		diff, err := planResource(r, "PN123", map[string]interface{}{
+			"phone_number_sid": "PN123",
+			"trunk_sid":        "",
+			"voice":            d.Get("voice").(*schema.Set).List(),
+			"sms":              d.Get("sms").(*schema.Set).List(),
+			"status_callback":  d.Get("status_callback").(*schema.Set).List(),
+			"emergency":        d.Get("emergency").(*schema.Set).List(),
+		}, map[string]interface{}{
+			"phone_number_sid": "PN123",
+			"voice": []interface{}{map[string]interface{}{
+				"primary_url":          "https://example.com/voice",
+				"primary_http_method":  "POST",
+				"fallback_url":         "https://example.com/voice-fallback",
+				"fallback_http_method": "GET",
+				"caller_id_enabled":    true,
+			}},
+			"sms": []interface{}{map[string]interface{}{
+				"primary_url":          "https://example.com/sms",
+				"primary_http_method":  "POST",
+				"fallback_http_method": "POST",
+			}},
+			"status_callback": []interface{}{map[string]interface{}{
+				"url":         "https://example.com/status",
+				"http_method": "POST",
+			}},
+			"emergency": []interface{}{map[string]interface{}{
+				"address_sid": "AD123",
+				"status":      "Active",
+			}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.Empty()).To(BeTrue(), "unexpected diff: %#v", diff)
+	})
+
+	It("clears a trunk that was removed from the configuration", func() {
+		r := resourceTwilioPhoneNumberConfiguration()
+		state := r.Data(nil)
+		Expect(state.Set("phone_number_sid", "PN123")).To(Succeed())
+		Expect(state.Set("trunk_sid", "TK123")).To(Succeed())
+		state.SetId("PN123")
+
+		diff, err := planResource(r, "PN123", map[string]interface{}{
+			"phone_number_sid": "PN123",
+			"trunk_sid":        "TK123",
+		}, map[string]interface{}{
+			"phone_number_sid": "PN123",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		d, err := schema.InternalMap(r.Schema).Data(state.State(), diff)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(makeConfigurationRequestPayload(d)).To(HaveKeyWithValue("TrunkSid", []string{""}))
+	})
+})
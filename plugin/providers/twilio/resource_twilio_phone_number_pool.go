@@ -0,0 +1,475 @@
+package twilio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform/helper/customdiff"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// phoneNumberPoolSharedAttributes lists the twilio_phone_number arguments that every number in a pool shares.
+var phoneNumberPoolSharedAttributes = []string{
+	"country_code",
+	"type",
+	"area_code",
+	"search",
+	"friendly_name",
+	"address_sid",
+	"identity_sid",
+	"bundle_sid",
+	"trunk_sid",
+	"sms",
+	"voice",
+	"status_callback",
+	"emergency",
+}
+
+func resourceTwilioPhoneNumberPool() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"size": {
+			Type:         schema.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(0),
+			Description:  "How many interchangeable numbers the pool holds.",
+		},
+		"release_order": {
+			Type:     schema.TypeString,
+			Optional: true,
+			Default:  "newest",
+			ValidateFunc: validation.StringInSlice([]string{
+				"oldest",
+				"newest",
+			}, false),
+			Description: "Which numbers are released first when `size` shrinks. Can be `oldest` or `newest`, defaults to `newest`.",
+		},
+		"concurrency": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      4,
+			ValidateFunc: validation.IntBetween(1, 20),
+			Description:  "How many numbers are purchased or updated at once. Defaults to `4`.",
+		},
+		"sids": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The SIDs of the numbers in the pool, oldest first.",
+		},
+		"numbers": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The E.164 representation of the numbers in the pool, in the same order as `sids`.",
+		},
+		"actual_size": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "How many numbers the pool currently holds. Differs from `size` when a purchase failed or numbers were released outside of Terraform; the next apply restores `size`.",
+		},
+	}
+
+	phoneNumberSchema := resourceTwilioPhoneNumber().Schema
+	for _, key := range phoneNumberPoolSharedAttributes {
+		s[key] = phoneNumberSchema[key]
+	}
+	for _, key := range phoneNumberSearchAttributes {
+		// Search changes only affect the numbers the pool buys from now on.
+		searchSchema := *s[key]
+		searchSchema.DiffSuppressFunc = nil
+		s[key] = &searchSchema
+	}
+	s["country_code"].Optional = false
+	s["country_code"].Required = true
+	s["country_code"].Description = "Two letter ISO country code in which numbers for the pool are searched."
+
+	return &schema.Resource{
+		Create: resourceTwilioPhoneNumberPoolCreate,
+		Read:   resourceTwilioPhoneNumberPoolRead,
+		Update: resourceTwilioPhoneNumberPoolUpdate,
+		Delete: resourceTwilioPhoneNumberPoolDelete,
+
+		CustomizeDiff: customdiff.All(
+			validatePhoneNumberRouting,
+			validatePhoneNumberAddressRequirements,
+			planTwilioPhoneNumberPoolResize,
+		),
+
+		Schema: s,
+	}
+}
+
+// growTwilioPhoneNumberPool buys `need` numbers matching the pool's search criteria, running up to `concurrency`
+// purchases at once. Candidates that fail to purchase are skipped in favor of the next one; the numbers that were
+// bought are returned alongside an error if the pool could not be filled.
+func growTwilioPhoneNumberPool(ctx context.Context, d *schema.ResourceData, meta interface{}, need int) ([]*incomingPhoneNumber, error) {
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	countryCode := d.Get("country_code").(string)
+	numType := d.Get("type").(string)
+	concurrency := d.Get("concurrency").(int)
+
+	limit := need * 2
+	if limit > 1000 {
+		limit = 1000
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":  config.AccountSID,
+			"country_code": countryCode,
+			"need":         need,
+		},
+	).Debug(fmt.Sprintf("START client.Available.Numbers.%s.GetPage", numType))
+
+	candidates, err := searchAvailablePhoneNumbers(ctx, client, countryCode, numType, makeAvailableNumberSearchParams(d), limit)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid":  config.AccountSID,
+				"country_code": countryCode,
+			},
+		).Error("Caught an unexpected error when searching for phone numbers")
+
+		return nil, err
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":  config.AccountSID,
+			"country_code": countryCode,
+			"result_count": len(candidates),
+		},
+	).Debug(fmt.Sprintf("END client.Available.Numbers.%s.GetPage", numType))
+
+	if len(candidates) == 0 {
+		return nil, errors.New("No numbers found that match your search")
+	}
+
+	queue := make(chan string, len(candidates))
+	for _, candidate := range candidates {
+		queue <- string(candidate.PhoneNumber)
+	}
+	close(queue)
+
+	buyParams := makeCreateRequestPayload(d)
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	var bought []*incomingPhoneNumber
+	var lastErr error
+	inFlight := 0
+
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for e164Number := range queue {
+				mu.Lock()
+				if len(bought)+inFlight >= need {
+					mu.Unlock()
+					return
+				}
+				inFlight++
+				mu.Unlock()
+
+				ph, err := buyTwilioPhoneNumber(ctx, meta, "", e164Number, buyParams)
+
+				mu.Lock()
+				inFlight--
+				if err != nil {
+					lastErr = err
+				} else {
+					bought = append(bought, ph)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if len(bought) < need {
+		if lastErr == nil {
+			lastErr = errors.New("not enough numbers match your search")
+		}
+		return bought, fmt.Errorf("Only purchased %d of %d phone numbers for the pool: %s", len(bought), need, lastErr)
+	}
+	return bought, nil
+}
+
+// selectTwilioPhoneNumberPoolSurplus returns the `surplus` SIDs to release first according to releaseOrder.
+func selectTwilioPhoneNumberPoolSurplus(sids []string, surplus int, releaseOrder string) []string {
+	if surplus > len(sids) {
+		surplus = len(sids)
+	}
+
+	selected := make([]string, 0, surplus)
+	for i := 0; i < surplus; i++ {
+		if releaseOrder == "oldest" {
+			selected = append(selected, sids[i])
+		} else {
+			selected = append(selected, sids[len(sids)-1-i])
+		}
+	}
+	return selected
+}
+
+// removeTwilioPhoneNumberPoolSids returns sids without the released ones, keeping the remaining SIDs in their original
+// order.
+func removeTwilioPhoneNumberPoolSids(sids []string, released map[string]bool) []string {
+	remaining := make([]string, 0, len(sids))
+	for _, sid := range sids {
+		if !released[sid] {
+			remaining = append(remaining, sid)
+		}
+	}
+	return remaining
+}
+
+// shrinkTwilioPhoneNumberPool releases `surplus` numbers from the pool, honoring `release_order`, and returns the SIDs
+// that remain. Numbers that were already released count as released.
+func shrinkTwilioPhoneNumberPool(ctx context.Context, d *schema.ResourceData, meta interface{}, sids []string, surplus int) ([]string, error) {
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	released := make(map[string]bool)
+	for _, sid := range selectTwilioPhoneNumberPoolSurplus(sids, surplus, d.Get("release_order").(string)) {
+		log.WithFields(
+			log.Fields{
+				"account_sid":      config.AccountSID,
+				"phone_number_sid": sid,
+			},
+		).Debug("START client.IncomingNumbers.Release")
+
+		if err := releaseIncomingPhoneNumber(ctx, client, "", sid); err != nil {
+			return removeTwilioPhoneNumberPoolSids(sids, released), fmt.Errorf("Failed to release pooled phone number SID %s: %s", sid, err)
+		}
+		released[sid] = true
+
+		log.WithFields(
+			log.Fields{
+				"account_sid":      config.AccountSID,
+				"phone_number_sid": sid,
+			},
+		).Debug("END client.IncomingNumbers.Release")
+	}
+
+	return removeTwilioPhoneNumberPoolSids(sids, released), nil
+}
+
+func getTwilioPhoneNumberPoolSids(d *schema.ResourceData) []string {
+	raw := d.Get("sids").([]interface{})
+	sids := make([]string, 0, len(raw))
+	for _, sid := range raw {
+		sids = append(sids, sid.(string))
+	}
+	return sids
+}
+
+func resourceTwilioPhoneNumberPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioPhoneNumberPoolCreate")
+
+	ctx := context.TODO()
+
+	id, err := uuid.GenerateUUID()
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	size := d.Get("size").(int)
+
+	var sids []string
+	if size > 0 {
+		var bought []*incomingPhoneNumber
+		bought, err = growTwilioPhoneNumberPool(ctx, d, meta, size)
+		for _, ph := range bought {
+			sids = append(sids, ph.Sid)
+		}
+	}
+
+	if setErr := d.Set("sids", sids); setErr != nil {
+		return setErr
+	}
+	if err != nil {
+		// The ID and the numbers bought so far are already in state, so they are still managed (and released) by the pool
+		return err
+	}
+
+	return resourceTwilioPhoneNumberPoolRead(d, meta)
+}
+
+func resourceTwilioPhoneNumberPoolRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioPhoneNumberPoolRead")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	ctx := context.TODO()
+
+	var sids []string
+	var numbers []string
+	for _, sid := range getTwilioPhoneNumberPoolSids(d) {
+		log.WithFields(
+			log.Fields{
+				"account_sid":      config.AccountSID,
+				"phone_number_sid": sid,
+			},
+		).Debug("START client.IncomingNumbers.Get")
+
+		ph, err := getIncomingPhoneNumber(ctx, client, "", sid)
+		if isNotFoundError(err) {
+			// Released outside of Terraform; the next apply buys a replacement
+			continue
+		}
+		if err != nil {
+			return fmt.Errorf("Encountered an error when getting pooled phone number SID %s: %s", sid, err)
+		}
+
+		log.WithFields(
+			log.Fields{
+				"account_sid":      config.AccountSID,
+				"phone_number_sid": sid,
+			},
+		).Debug("END client.IncomingNumbers.Get")
+
+		sids = append(sids, ph.Sid)
+		numbers = append(numbers, string(ph.PhoneNumber))
+	}
+
+	err := d.Set("sids", sids)
+	if err == nil {
+		err = d.Set("numbers", numbers)
+	}
+	if err == nil {
+		err = d.Set("actual_size", len(sids))
+	}
+	return err
+}
+
+// planTwilioPhoneNumberPoolResize marks the pool's numbers as changing when `size` changes or when numbers were
+// released outside of Terraform, so that the next apply buys replacements.
+func planTwilioPhoneNumberPoolResize(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+	if !d.HasChange("size") && d.Get("actual_size").(int) == d.Get("size").(int) {
+		return nil
+	}
+
+	for _, key := range []string{"sids", "numbers", "actual_size"} {
+		if err := d.SetNewComputed(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func resourceTwilioPhoneNumberPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioPhoneNumberPoolUpdate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	ctx := context.TODO()
+
+	sids := getTwilioPhoneNumberPoolSids(d)
+
+	configChanged := false
+	for _, key := range []string{"friendly_name", "address_sid", "identity_sid", "bundle_sid", "trunk_sid", "sms", "voice", "status_callback", "emergency"} {
+		if d.HasChange(key) {
+			configChanged = true
+		}
+	}
+
+	if configChanged && len(sids) > 0 {
+		updatePayload := makeCreateRequestPayload(d)
+
+		queue := make(chan string, len(sids))
+		for _, sid := range sids {
+			queue <- sid
+		}
+		close(queue)
+
+		var mu sync.Mutex
+		var wg sync.WaitGroup
+		var lastErr error
+
+		for i := 0; i < d.Get("concurrency").(int); i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				for sid := range queue {
+					log.WithFields(
+						log.Fields{
+							"account_sid": config.AccountSID,
+							"phone_sid":   sid,
+						},
+					).Debug("START client.IncomingNumbers.Update")
+
+					if _, err := updateIncomingPhoneNumber(ctx, client, "", sid, updatePayload); err != nil {
+						mu.Lock()
+						lastErr = fmt.Errorf("Failed to update pooled phone number SID %s: %s", sid, err)
+						mu.Unlock()
+						continue
+					}
+
+					log.WithFields(
+						log.Fields{
+							"account_sid": config.AccountSID,
+							"phone_sid":   sid,
+						},
+					).Debug("END client.IncomingNumbers.Update")
+				}
+			}()
+		}
+		wg.Wait()
+
+		if lastErr != nil {
+			return lastErr
+		}
+	}
+
+	size := d.Get("size").(int)
+
+	var err error
+	if len(sids) < size {
+		var bought []*incomingPhoneNumber
+		bought, err = growTwilioPhoneNumberPool(ctx, d, meta, size-len(sids))
+		for _, ph := range bought {
+			sids = append(sids, ph.Sid)
+		}
+	} else if len(sids) > size {
+		sids, err = shrinkTwilioPhoneNumberPool(ctx, d, meta, sids, len(sids)-size)
+	}
+
+	if setErr := d.Set("sids", sids); setErr != nil {
+		return setErr
+	}
+	if err != nil {
+		return err
+	}
+
+	return resourceTwilioPhoneNumberPoolRead(d, meta)
+}
+
+func resourceTwilioPhoneNumberPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioPhoneNumberPoolDelete")
+
+	sids := getTwilioPhoneNumberPoolSids(d)
+
+	remaining, err := shrinkTwilioPhoneNumberPool(context.TODO(), d, meta, sids, len(sids))
+	if err != nil {
+		if setErr := d.Set("sids", remaining); setErr != nil {
+			return setErr
+		}
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,120 @@
+package twilio
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+
+	"github.com/kevinburke/twilio-go"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Phone number pool", func() {
+	sids := []string{"PN1", "PN2", "PN3", "PN4"}
+
+	Describe("selectTwilioPhoneNumberPoolSurplus", func() {
+		It("releases the first numbers when release_order is oldest", func() {
+			Expect(selectTwilioPhoneNumberPoolSurplus(sids, 2, "oldest")).To(Equal([]string{"PN1", "PN2"}))
+		})
+
+		It("releases the last numbers, newest first, when release_order is newest", func() {
+			Expect(selectTwilioPhoneNumberPoolSurplus(sids, 2, "newest")).To(Equal([]string{"PN4", "PN3"}))
+		})
+
+		It("never selects more numbers than the pool holds", func() {
+			Expect(selectTwilioPhoneNumberPoolSurplus(sids, 10, "oldest")).To(Equal(sids))
+		})
+	})
+
+	Describe("removeTwilioPhoneNumberPoolSids", func() {
+		It("keeps the remaining numbers in their original order", func() {
+			released := map[string]bool{"PN2": true, "PN4": true}
+			Expect(removeTwilioPhoneNumberPoolSids(sids, released)).To(Equal([]string{"PN1", "PN3"}))
+		})
+	})
+
+	Describe("shrinkTwilioPhoneNumberPool", func() {
+		var server *httptest.Server
+		var released []string
+
+		BeforeEach(func() {
+			released = nil
+			server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				sid := strings.TrimSuffix(r.URL.Path[strings.LastIndex(r.URL.Path, "/")+1:], ".json")
+				released = append(released, sid)
+
+				switch sid {
+				case "PN3":
+					// Already released outside of Terraform
+					w.WriteHeader(http.StatusNotFound)
+					w.Write([]byte(`{"code": 20404, "message": "not found", "status": 404}`))
+				case "PN2":
+					w.WriteHeader(http.StatusInternalServerError)
+					w.Write([]byte(`{"code": 20500, "message": "internal error", "status": 500}`))
+				default:
+					w.WriteHeader(http.StatusNoContent)
+				}
+			}))
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		shrink := func(surplus int) ([]string, error) {
+			client := twilio.NewClient("AC123", "token", server.Client())
+			client.Base = server.URL
+			meta := &TerraformTwilioContext{client: client, configuration: Config{AccountSID: "AC123"}}
+
+			d := resourceTwilioPhoneNumberPool().Data(nil)
+			Expect(d.Set("release_order", "newest")).To(Succeed())
+
+			return shrinkTwilioPhoneNumberPool(context.TODO(), d, meta, sids, surplus)
+		}
+
+		It("treats numbers that are already gone as released", func() {
+			remaining, err := shrink(2)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(released).To(Equal([]string{"PN4", "PN3"}))
+			Expect(remaining).To(Equal([]string{"PN1", "PN2"}))
+		})
+
+		It("keeps the order of the pool when a release fails", func() {
+			remaining, err := shrink(3)
+			Expect(err).To(HaveOccurred())
+			Expect(released).To(Equal([]string{"PN4", "PN3", "PN2"}))
+			Expect(remaining).To(Equal([]string{"PN1", "PN2"}))
+		})
+	})
+
+	Describe("resourceTwilioPhoneNumberPoolCreate", func() {
+		var fake *fakeTwilio
+
+		BeforeEach(func() {
+			fake = newFakeTwilio(map[string]string{
+				"GET /2010-04-01/Accounts/AC123/AvailablePhoneNumbers/US/Local.json": `{"available_phone_numbers": [{"phone_number": "+15550001111"}]}`,
+				"POST /2010-04-01/Accounts/AC123/IncomingPhoneNumbers.json":          `{"sid": "PN1", "phone_number": "+15550001111"}`,
+			})
+		})
+
+		AfterEach(func() {
+			fake.Close()
+		})
+
+		It("fails when the pool cannot be filled, keeping the numbers it bought in state", func() {
+			d := resourceTwilioPhoneNumberPool().Data(nil)
+			Expect(d.Set("country_code", "US")).To(Succeed())
+			Expect(d.Set("type", "Local")).To(Succeed())
+			Expect(d.Set("concurrency", 1)).To(Succeed())
+			Expect(d.Set("size", 2)).To(Succeed())
+
+			err := resourceTwilioPhoneNumberPoolCreate(d, fake.meta())
+			Expect(err).To(MatchError(ContainSubstring("Only purchased 1 of 2 phone numbers")))
+
+			Expect(d.Id()).NotTo(BeEmpty())
+			Expect(getTwilioPhoneNumberPoolSids(d)).To(Equal([]string{"PN1"}))
+		})
+	})
+})
@@ -0,0 +1,193 @@
+package twilio
+
+import (
+	"github.com/hashicorp/terraform/helper/schema"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("validatePhoneNumberRouting", func() {
+	var r *schema.Resource
+
+	BeforeEach(func() {
+		r = &schema.Resource{
+			Schema:        resourceTwilioPhoneNumber().Schema,
+			CustomizeDiff: validatePhoneNumberRouting,
+		}
+	})
+
+	voice := func(block map[string]interface{}) []interface{} {
+		return []interface{}{block}
+	}
+
+	It("rejects a trunk configured together with a voice URL", func() {
+		errs := validateResource(r, map[string]interface{}{
+			"trunk_sid": "TK123",
+			"voice":     voice(map[string]interface{}{"primary_url": "https://example.com/voice"}),
+		})
+		Expect(errs).To(ConsistOf(MatchError(ContainSubstring("conflicts with voice.0.primary_url"))))
+	})
+
+	It("rejects a trunk configured together with a voice application", func() {
+		errs := validateResource(r, map[string]interface{}{
+			"trunk_sid": "TK123",
+			"voice":     voice(map[string]interface{}{"application_sid": "AP123"}),
+		})
+		Expect(errs).To(ConsistOf(MatchError(ContainSubstring("conflicts with voice.0.application_sid"))))
+	})
+
+	It("rejects a trunk added to an existing number whose configured voice URL is unchanged", func() {
+		config := map[string]interface{}{
+			"trunk_sid": "TK123",
+			"voice":     voice(map[string]interface{}{"primary_url": "https://example.com/voice"}),
+		}
+		diff, err := planResource(r, "PN123", map[string]interface{}{
+			"voice": voice(map[string]interface{}{"primary_url": "https://example.com/voice"}),
+		}, config)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(diff.Attributes).To(HaveKey("trunk_sid"))
+		Expect(diff.Attributes).NotTo(HaveKey("voice.#"))
+
+		Expect(validateResource(r, config)).To(ConsistOf(MatchError(ContainSubstring("conflicts with voice.0.primary_url"))))
+	})
+
+	It("accepts a trunk added to a number whose voice URL was only read back", func() {
+		config := map[string]interface{}{
+			"trunk_sid": "TK123",
+		}
+		Expect(validateResource(r, config)).To(BeEmpty())
+
+		_, err := planResource(r, "PN123", map[string]interface{}{
+			"voice": voice(map[string]interface{}{"primary_url": "https://example.com/voice", "receive_mode": "voice"}),
+		}, config)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("rejects a voice application configured together with a voice URL", func() {
+		_, err := planResource(r, "", nil, map[string]interface{}{
+			"voice": voice(map[string]interface{}{"application_sid": "AP123", "primary_url": "https://example.com/voice"}),
+		})
+		Expect(err).To(MatchError(ContainSubstring("'voice.application_sid' and 'voice.primary_url' cannot both be set")))
+	})
+
+	It("rejects an SMS application configured together with an SMS URL", func() {
+		_, err := planResource(r, "", nil, map[string]interface{}{
+			"sms": []interface{}{map[string]interface{}{"application_sid": "AP123", "primary_url": "https://example.com/sms"}},
+		})
+		Expect(err).To(MatchError(ContainSubstring("'sms.application_sid' and 'sms.primary_url' cannot both be set")))
+	})
+
+	It("rejects active emergency calling without an address", func() {
+		_, err := planResource(r, "", nil, map[string]interface{}{
+			"emergency": []interface{}{map[string]interface{}{"status": "Active"}},
+		})
+		Expect(err).To(MatchError(ContainSubstring("'emergency.address_sid' must be set")))
+	})
+
+	It("accepts routing without conflicts", func() {
+		_, err := planResource(r, "", nil, map[string]interface{}{
+			"voice":     voice(map[string]interface{}{"primary_url": "https://example.com/voice"}),
+			"emergency": []interface{}{map[string]interface{}{"status": "Active", "address_sid": "AD123"}},
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
+
+var _ = Describe("resourceTwilioPhoneNumberDelete", func() {
+	var fake *fakeTwilio
+
+	BeforeEach(func() {
+		fake = newFakeTwilio(map[string]string{
+			"GET /v1/Services":                                                `{"services": [{"sid": "MG123"}], "meta": {}}`,
+			"GET /v1/Services/MG123/PhoneNumbers":                             `{"phone_numbers": [{"sid": "PN123"}], "meta": {}}`,
+			"POST /2010-04-01/Accounts/AC123/IncomingPhoneNumbers/PN123.json": `{"sid": "PN123"}`,
+		})
+	})
+
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	destroy := func(attributes map[string]interface{}) error {
+		d := resourceTwilioPhoneNumber().Data(nil)
+		for key, value := range attributes {
+			Expect(d.Set(key, value)).To(Succeed())
+		}
+		d.SetId("PN123")
+		return resourceTwilioPhoneNumberDelete(d, fake.meta())
+	}
+
+	It("detaches rather than releases an adopted number by default", func() {
+		Expect(destroy(map[string]interface{}{"existing_number": "+15551234567"})).To(Succeed())
+
+		Expect(fake.requestsMatching("DELETE", "IncomingPhoneNumbers")).To(BeEmpty())
+		Expect(fake.requestsMatching("POST", "IncomingPhoneNumbers/PN123")).To(HaveLen(1))
+	})
+
+	It("releases an adopted number when on_destroy is release", func() {
+		Expect(destroy(map[string]interface{}{"existing_number": "+15551234567", "on_destroy": "release"})).To(Succeed())
+
+		Expect(fake.requestsMatching("DELETE", "IncomingPhoneNumbers/PN123")).To(HaveLen(1))
+	})
+
+	It("detaches a number when the deprecated release_on_destroy is false", func() {
+		Expect(destroy(map[string]interface{}{"release_on_destroy": false})).To(Succeed())
+
+		Expect(fake.requestsMatching("DELETE", "IncomingPhoneNumbers")).To(BeEmpty())
+	})
+
+	It("releases a purchased number by default", func() {
+		Expect(destroy(map[string]interface{}{"country_code": "US"})).To(Succeed())
+
+		Expect(fake.requestsMatching("DELETE", "IncomingPhoneNumbers/PN123")).To(HaveLen(1))
+	})
+
+	It("detaches a number without releasing it when on_destroy is detach", func() {
+		Expect(destroy(map[string]interface{}{"country_code": "US", "on_destroy": "detach"})).To(Succeed())
+
+		Expect(fake.requestsMatching("DELETE", "IncomingPhoneNumbers")).To(BeEmpty())
+		Expect(fake.requestsMatching("DELETE", "Services/MG123/PhoneNumbers/PN123")).To(HaveLen(1))
+		Expect(fake.forms["POST /2010-04-01/Accounts/AC123/IncomingPhoneNumbers/PN123.json"]).To(HaveKeyWithValue("SmsUrl", []string{""}))
+	})
+
+	It("parks a number under park_friendly_name without releasing it when on_destroy is park", func() {
+		Expect(destroy(map[string]interface{}{"country_code": "US", "on_destroy": "park", "park_friendly_name": "parked"})).To(Succeed())
+
+		Expect(fake.requestsMatching("DELETE", "IncomingPhoneNumbers")).To(BeEmpty())
+		Expect(fake.requestsMatching("DELETE", "Services/MG123/PhoneNumbers/PN123")).To(HaveLen(1))
+		Expect(fake.forms["POST /2010-04-01/Accounts/AC123/IncomingPhoneNumbers/PN123.json"].Get("FriendlyName")).To(Equal("parked"))
+	})
+
+	It("refuses to destroy a number with deletion_protection enabled", func() {
+		err := destroy(map[string]interface{}{"country_code": "US", "deletion_protection": true})
+		Expect(err).To(MatchError(ContainSubstring("has deletion_protection enabled")))
+
+		Expect(fake.requests).To(BeEmpty())
+	})
+})
+
+var _ = Describe("resourceTwilioPhoneNumberCreate", func() {
+	var fake *fakeTwilio
+
+	BeforeEach(func() {
+		fake = newFakeTwilio(map[string]string{
+			"POST /2010-04-01/Accounts/AC123/IncomingPhoneNumbers.json": `{"sid": "PN123", "account_sid": "AC123", "phone_number": "+15550001111"}`,
+		})
+	})
+
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	It("purchases exactly the number set in phone_number without searching", func() {
+		d := resourceTwilioPhoneNumber().Data(nil)
+		Expect(d.Set("phone_number", "+15550001111")).To(Succeed())
+
+		Expect(resourceTwilioPhoneNumberCreate(d, fake.meta())).To(Succeed())
+
+		Expect(d.Id()).To(Equal("PN123"))
+		Expect(d.Get("number")).To(Equal("+15550001111"))
+		Expect(fake.requestsMatching("GET", "AvailablePhoneNumbers")).To(BeEmpty())
+		Expect(fake.forms["POST /2010-04-01/Accounts/AC123/IncomingPhoneNumbers.json"].Get("PhoneNumber")).To(Equal("+15550001111"))
+	})
+})
@@ -2,12 +2,12 @@ package twilio
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"github.com/kevinburke/twilio-go"
 	"net/url"
 
 	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
 
 	log "github.com/sirupsen/logrus"
 )
@@ -24,30 +24,63 @@ func resourceTwilioSubaccount() *schema.Resource {
 
 		Schema: map[string]*schema.Schema{
 			"parent_account_sid": {
-                Type:     schema.TypeString,
-                Computed: true,
-            },
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"friendly_name": {
-                Type:     schema.TypeString,
-                Optional: true,
-            },
+				Type:     schema.TypeString,
+				Optional: true,
+			},
 			"status": {
-                Type:     schema.TypeString,
-                Optional: true,
-                Default:  "active",
-            },
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "active",
+				ValidateFunc: validateSubaccountStatus,
+				Description:  "Either `active` or `suspended`. Closing a subaccount is irreversible and only happens when it is destroyed. Defaults to `active`.",
+			},
+			"on_destroy": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Default:  "close",
+				ValidateFunc: validation.StringInSlice([]string{
+					"close",
+					"suspend",
+					"abandon",
+				}, false),
+				Description: "What happens to the subaccount on destroy. `close` permanently closes it and releases its phone numbers, `suspend` suspends it, and `abandon` leaves it untouched. Defaults to `close`.",
+			},
+			"force_close": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If `true`, the subaccount is closed on destroy even if it still owns phone numbers. Defaults to `false`.",
+			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If `true`, destroying this resource fails. Defaults to `false`.",
+			},
+			"store_auth_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If `false`, the subaccount's auth token is never written to state. Defaults to `true`.",
+			},
 			"auth_token": {
-                Type:     schema.TypeString,
-                Computed: true,
-            },
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The subaccount's primary auth token. Empty if `store_auth_token` is `false`.",
+			},
 			"date_created": {
-                Type:     schema.TypeString,
-                Computed: true,
-            },
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"date_updated": {
-                Type:     schema.TypeString,
-                Computed: true,
-            },
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 		},
 	}
 }
@@ -60,10 +93,40 @@ func flattenSubaccountForCreate(d *schema.ResourceData) url.Values {
 	return v
 }
 
+// validateSubaccountStatus only accepts the statuses a subaccount can move between, so that `closed` is rejected at
+// plan time rather than irreversibly closing the subaccount.
+func validateSubaccountStatus(v interface{}, k string) (ws []string, errs []error) {
+	switch status := v.(string); status {
+	case "active", "suspended":
+	case "closed":
+		errs = append(errs, fmt.Errorf("%q cannot be set to closed: closing a subaccount is irreversible, destroy the resource instead", k))
+	default:
+		errs = append(errs, fmt.Errorf("%q must be either active or suspended, got %s", k, status))
+	}
+	return
+}
+
+func flattenSubaccountForUpdate(d *schema.ResourceData) url.Values {
+	v := make(url.Values)
+
+	if d.HasChange("friendly_name") {
+		v.Add("FriendlyName", d.Get("friendly_name").(string))
+	}
+	if d.HasChange("status") {
+		v.Add("Status", d.Get("status").(string))
+	}
+
+	return v
+}
+
 func flattenSubaccountForDelete(d *schema.ResourceData) url.Values {
 	v := make(url.Values)
 
-	v.Add("status", "closed")
+	if d.Get("on_destroy").(string) == "suspend" {
+		v.Add("Status", "suspended")
+	} else {
+		v.Add("Status", "closed")
+	}
 
 	return v
 }
@@ -95,14 +158,23 @@ func resourceTwilioSubaccountCreate(d *schema.ResourceData, meta interface{}) er
 	}
 
 	d.SetId(createResult.Sid)
-	if err = mapTwilioSubaccountToTerraform(createResult, d); err != nil {
-        log.WithFields(
-            log.Fields{
-                "parent_account_sid": config.AccountSID,
-            },
-        ).WithError(err).Error("ERROR mapTwilioSubaccountToTerraform")
-	    return err
-    }
+
+	// Subaccounts are always created active.
+	if status := d.Get("status").(string); status != string(createResult.Status) {
+		createResult, err = client.Accounts.Update(context.TODO(), createResult.Sid, url.Values{"Status": []string{status}})
+		if err != nil {
+			return fmt.Errorf("Failed to set status of account %s to %s: %s", d.Id(), status, err.Error())
+		}
+	}
+
+	if err = mapTwilioSubaccountToTerraform(createResult, d, d.Get("store_auth_token").(bool)); err != nil {
+		log.WithFields(
+			log.Fields{
+				"parent_account_sid": config.AccountSID,
+			},
+		).WithError(err).Error("ERROR mapTwilioSubaccountToTerraform")
+		return err
+	}
 
 	log.WithFields(
 		log.Fields{
@@ -131,7 +203,7 @@ func resourceTwilioSubaccountRead(d *schema.ResourceData, meta interface{}) erro
 
 	account, err := client.Accounts.Get(context.TODO(), sid)
 	if err == nil {
-		err = mapTwilioSubaccountToTerraform(account, d)
+		err = mapTwilioSubaccountToTerraform(account, d, d.Get("store_auth_token").(bool))
 	}
 
 	if err != nil {
@@ -148,10 +220,16 @@ func resourceTwilioSubaccountRead(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
-func mapTwilioSubaccountToTerraform(account *twilio.Account, d *schema.ResourceData) error {
+// mapTwilioSubaccountToTerraform maps account onto d. Its auth token is only kept if storeAuthToken is true.
+func mapTwilioSubaccountToTerraform(account *twilio.Account, d *schema.ResourceData, storeAuthToken bool) error {
+	authToken := ""
+	if storeAuthToken {
+		authToken = account.AuthToken
+	}
+
 	err := d.Set("status", account.Status)
 	if err == nil {
-		err = d.Set("auth_token", account.AuthToken)
+		err = d.Set("auth_token", authToken)
 	}
 	if err == nil {
 		err = d.Set("friendly_name", account.FriendlyName)
@@ -169,7 +247,46 @@ func mapTwilioSubaccountToTerraform(account *twilio.Account, d *schema.ResourceD
 }
 
 func resourceTwilioSubaccountUpdate(d *schema.ResourceData, meta interface{}) error {
-	return errors.New("Not implemented")
+	log.Debug("ENTER resourceTwilioSubaccountUpdate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	sid := d.Id()
+
+	updateData := flattenSubaccountForUpdate(d)
+	if len(updateData) == 0 {
+		return resourceTwilioSubaccountRead(d, meta)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"parent_account_sid": config.AccountSID,
+			"subaccount_sid":     sid,
+			"status":             updateData.Get("Status"),
+		},
+	).Debug("START client.Accounts.Update")
+
+	account, err := client.Accounts.Update(context.TODO(), sid, updateData)
+	if err != nil {
+		log.WithFields(
+			log.Fields{
+				"parent_account_sid": config.AccountSID,
+				"subaccount_sid":     sid,
+			},
+		).WithError(err).Error("client.Accounts.Update failed")
+
+		return fmt.Errorf("Failed to update account: %s", err.Error())
+	}
+
+	log.WithFields(
+		log.Fields{
+			"parent_account_sid": config.AccountSID,
+			"subaccount_sid":     sid,
+		},
+	).Debug("END client.Accounts.Update")
+
+	return mapTwilioSubaccountToTerraform(account, d, d.Get("store_auth_token").(bool))
 }
 
 func resourceTwilioSubaccountDelete(d *schema.ResourceData, meta interface{}) error {
@@ -179,6 +296,31 @@ func resourceTwilioSubaccountDelete(d *schema.ResourceData, meta interface{}) er
 	config := meta.(*TerraformTwilioContext).configuration
 
 	sid := d.Id()
+	onDestroy := d.Get("on_destroy").(string)
+
+	if d.Get("deletion_protection").(bool) {
+		return fmt.Errorf("Subaccount %s has deletion_protection enabled, set it to false and apply before destroying", sid)
+	}
+
+	if onDestroy == "abandon" {
+		log.WithFields(
+			log.Fields{
+				"parent_account_sid": config.AccountSID,
+				"subaccount_sid":     sid,
+			},
+		).Info("on_destroy is abandon, leaving the subaccount untouched")
+		return nil
+	}
+
+	if onDestroy == "close" && !d.Get("force_close").(bool) {
+		numbers, err := listIncomingPhoneNumbers(context.TODO(), client, sid, nil)
+		if err != nil {
+			return fmt.Errorf("Failed to list the phone numbers of account %s before closing it: %s", sid, err.Error())
+		}
+		if len(numbers) > 0 {
+			return fmt.Errorf("Subaccount %s still owns %d phone number(s), which closing it would release; set force_close = true or on_destroy = \"suspend\" to destroy it", sid, len(numbers))
+		}
+	}
 
 	updateData := flattenSubaccountForDelete(d)
 
@@ -0,0 +1,306 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/kevinburke/twilio-go"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	accountsBaseURL = "https://accounts.twilio.com"
+	accountsVersion = "v1"
+
+	secondaryAuthTokenPathPart = "AuthTokens/Secondary"
+	promoteAuthTokenPathPart   = "AuthTokens/Promote"
+)
+
+// secondaryAuthToken is a secondary auth token, or the promoted primary token, as returned by the Accounts v1 API.
+type secondaryAuthToken struct {
+	AccountSid         string            `json:"account_sid"`
+	SecondaryAuthToken string            `json:"secondary_auth_token"`
+	DateCreated        twilio.TwilioTime `json:"date_created"`
+	DateUpdated        twilio.TwilioTime `json:"date_updated"`
+}
+
+// newAccountsClient returns a client for the Accounts v1 API acting as accountSid. The Accounts API only acts on the
+// account it is authenticated as, so a subaccount's current auth token is looked up with the provider's credentials
+// first. The provider's `endpoint` only applies to the core API, so the Accounts API is always reached at its own host.
+func newAccountsClient(ctx context.Context, meta interface{}, accountSid string) (*twilio.Client, error) {
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	authToken := config.AuthToken
+	if accountSid != config.AccountSID {
+		account, err := client.Accounts.Get(ctx, accountSid)
+		if err != nil {
+			return nil, fmt.Errorf("Encountered an error when getting the auth token of account %s: %s", accountSid, err)
+		}
+		authToken = account.AuthToken
+	}
+
+	accountsClient := twilio.NewMonitorClient(accountSid, authToken, nil)
+	accountsClient.Base = accountsBaseURL
+	accountsClient.APIVersion = accountsVersion
+	return accountsClient, nil
+}
+
+func resourceTwilioSubaccountSecondaryAuthToken() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTwilioSubaccountSecondaryAuthTokenCreate,
+		Read:   resourceTwilioSubaccountSecondaryAuthTokenRead,
+		Update: resourceTwilioSubaccountSecondaryAuthTokenUpdate,
+		Delete: resourceTwilioSubaccountSecondaryAuthTokenDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceTwilioSubaccountSecondaryAuthTokenImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"subaccount_sid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "SID of the subaccount (AC...) the secondary auth token is created for.",
+			},
+			"promote": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If `true`, the secondary token replaces the subaccount's primary auth token, which stops working. Promotion cannot be undone. Defaults to `false`.",
+			},
+			"secondary_auth_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The secondary auth token. Once promoted, it is the subaccount's primary auth token.",
+			},
+			"promoted": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the token has been promoted to primary.",
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func mapTwilioSecondaryAuthTokenToTerraform(token *secondaryAuthToken, d *schema.ResourceData) error {
+	err := d.Set("subaccount_sid", token.AccountSid)
+	if err == nil && token.DateCreated.Valid {
+		err = d.Set("date_created", token.DateCreated.Time.Format("2006-01-02T15:04:05-07:00"))
+	}
+	if err == nil && token.DateUpdated.Valid {
+		err = d.Set("date_updated", token.DateUpdated.Time.Format("2006-01-02T15:04:05-07:00"))
+	}
+	return err
+}
+
+// promoteSecondaryAuthToken makes the secondary auth token of accountSid its primary token.
+func promoteSecondaryAuthToken(d *schema.ResourceData, meta interface{}, accountSid string) error {
+	config := meta.(*TerraformTwilioContext).configuration
+
+	log.WithFields(
+		log.Fields{
+			"parent_account_sid": config.AccountSID,
+			"subaccount_sid":     accountSid,
+		},
+	).Debug("START client.Accounts.AuthTokens.Promote")
+
+	ctx := context.TODO()
+
+	accountsClient, err := newAccountsClient(ctx, meta, accountSid)
+	if err != nil {
+		return err
+	}
+
+	token := new(secondaryAuthToken)
+	if err := accountsClient.CreateResource(ctx, promoteAuthTokenPathPart, nil, token); err != nil {
+		log.WithFields(
+			log.Fields{
+				"parent_account_sid": config.AccountSID,
+				"subaccount_sid":     accountSid,
+			},
+		).WithError(err).Error("ERROR client.Accounts.AuthTokens.Promote")
+
+		return fmt.Errorf("Failed to promote the secondary auth token of account %s: %s", accountSid, err.Error())
+	}
+
+	log.WithFields(
+		log.Fields{
+			"parent_account_sid": config.AccountSID,
+			"subaccount_sid":     accountSid,
+		},
+	).Debug("END client.Accounts.AuthTokens.Promote")
+
+	err = d.Set("promoted", true)
+	if err == nil {
+		err = mapTwilioSecondaryAuthTokenToTerraform(token, d)
+	}
+	return err
+}
+
+func resourceTwilioSubaccountSecondaryAuthTokenCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSubaccountSecondaryAuthTokenCreate")
+
+	config := meta.(*TerraformTwilioContext).configuration
+
+	accountSid := d.Get("subaccount_sid").(string)
+
+	log.WithFields(
+		log.Fields{
+			"parent_account_sid": config.AccountSID,
+			"subaccount_sid":     accountSid,
+		},
+	).Debug("START client.Accounts.AuthTokens.CreateSecondary")
+
+	ctx := context.TODO()
+
+	accountsClient, err := newAccountsClient(ctx, meta, accountSid)
+	if err != nil {
+		return err
+	}
+
+	token := new(secondaryAuthToken)
+	if err := accountsClient.CreateResource(ctx, secondaryAuthTokenPathPart, nil, token); err != nil {
+		log.WithFields(
+			log.Fields{
+				"parent_account_sid": config.AccountSID,
+				"subaccount_sid":     accountSid,
+			},
+		).WithError(err).Error("ERROR client.Accounts.AuthTokens.CreateSecondary")
+
+		return fmt.Errorf("Failed to create a secondary auth token for account %s: %s", accountSid, err.Error())
+	}
+
+	log.WithFields(
+		log.Fields{
+			"parent_account_sid": config.AccountSID,
+			"subaccount_sid":     accountSid,
+		},
+	).Debug("END client.Accounts.AuthTokens.CreateSecondary")
+
+	d.SetId(accountSid)
+
+	err = d.Set("secondary_auth_token", token.SecondaryAuthToken)
+	if err == nil {
+		err = d.Set("promoted", false)
+	}
+	if err == nil {
+		err = mapTwilioSecondaryAuthTokenToTerraform(token, d)
+	}
+	if err != nil {
+		return fmt.Errorf("Encountered error while mapping the secondary auth token of account %s to TF: %s", accountSid, err)
+	}
+
+	if d.Get("promote").(bool) {
+		return promoteSecondaryAuthToken(d, meta, accountSid)
+	}
+	return nil
+}
+
+// resourceTwilioSubaccountSecondaryAuthTokenRead only checks that the subaccount still exists: Twilio has no way to read
+// a secondary auth token back.
+func resourceTwilioSubaccountSecondaryAuthTokenRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSubaccountSecondaryAuthTokenRead")
+
+	client := meta.(*TerraformTwilioContext).client
+
+	account, err := client.Accounts.Get(context.TODO(), d.Id())
+	if isNotFoundError(err) {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("Failed to refresh account: %s", err.Error())
+	}
+
+	if account.Status == twilio.StatusClosed {
+		d.SetId("")
+		return nil
+	}
+	return d.Set("subaccount_sid", account.Sid)
+}
+
+// resourceTwilioSubaccountSecondaryAuthTokenUpdate promotes the token when `promote` is turned on. Turning it off again
+// has no effect.
+func resourceTwilioSubaccountSecondaryAuthTokenUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSubaccountSecondaryAuthTokenUpdate")
+
+	if d.HasChange("promote") && d.Get("promote").(bool) && !d.Get("promoted").(bool) {
+		return promoteSecondaryAuthToken(d, meta, d.Id())
+	}
+	return nil
+}
+
+// resourceTwilioSubaccountSecondaryAuthTokenDelete deletes the secondary auth token. A promoted token is the primary auth
+// token of the subaccount and is left alone.
+func resourceTwilioSubaccountSecondaryAuthTokenDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioSubaccountSecondaryAuthTokenDelete")
+
+	config := meta.(*TerraformTwilioContext).configuration
+
+	accountSid := d.Id()
+
+	if d.Get("promoted").(bool) {
+		return nil
+	}
+
+	log.WithFields(
+		log.Fields{
+			"parent_account_sid": config.AccountSID,
+			"subaccount_sid":     accountSid,
+		},
+	).Debug("START client.Accounts.AuthTokens.DeleteSecondary")
+
+	ctx := context.TODO()
+
+	accountsClient, err := newAccountsClient(ctx, meta, accountSid)
+	if err != nil {
+		return err
+	}
+
+	if err := accountsClient.DeleteResource(ctx, "AuthTokens", "Secondary"); err != nil {
+		log.WithFields(
+			log.Fields{
+				"parent_account_sid": config.AccountSID,
+				"subaccount_sid":     accountSid,
+			},
+		).WithError(err).Error("ERROR client.Accounts.AuthTokens.DeleteSecondary")
+
+		return fmt.Errorf("Failed to delete the secondary auth token of account %s: %s", accountSid, err.Error())
+	}
+
+	log.WithFields(
+		log.Fields{
+			"parent_account_sid": config.AccountSID,
+			"subaccount_sid":     accountSid,
+		},
+	).Debug("END client.Accounts.AuthTokens.DeleteSecondary")
+
+	return nil
+}
+
+// resourceTwilioSubaccountSecondaryAuthTokenImport imports the secondary auth token of the subaccount SID in the import
+// ID. The token itself cannot be read back, so `secondary_auth_token` stays empty until it is recreated.
+func resourceTwilioSubaccountSecondaryAuthTokenImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	err := d.Set("subaccount_sid", d.Id())
+	if err == nil {
+		err = d.Set("promote", false)
+	}
+	if err == nil {
+		err = d.Set("promoted", false)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,44 @@
+package twilio
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("newAccountsClient", func() {
+	var fake *fakeTwilio
+	var meta *TerraformTwilioContext
+
+	BeforeEach(func() {
+		fake = newFakeTwilio(map[string]string{
+			"GET /2010-04-01/Accounts/ACsub.json": `{"sid": "ACsub", "auth_token": "subtoken"}`,
+		})
+		meta = fake.meta()
+		meta.configuration.Endpoint = "https://api.example.com"
+	})
+
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	It("talks to the Accounts API as the subaccount, ignoring the provider endpoint", func() {
+		client, err := newAccountsClient(context.TODO(), meta, "ACsub")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(client.Base).To(Equal("https://accounts.twilio.com"))
+		Expect(client.APIVersion).To(Equal("v1"))
+		Expect(client.AccountSid).To(Equal("ACsub"))
+		Expect(client.AuthToken).To(Equal("subtoken"))
+	})
+
+	It("uses the provider credentials for the provider's own account", func() {
+		client, err := newAccountsClient(context.TODO(), meta, "AC123")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(client.Base).To(Equal("https://accounts.twilio.com"))
+		Expect(client.AuthToken).To(Equal("token"))
+		Expect(fake.requests).To(BeEmpty())
+	})
+})
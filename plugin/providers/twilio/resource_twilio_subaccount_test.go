@@ -0,0 +1,77 @@
+package twilio
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("validateSubaccountStatus", func() {
+	It("accepts the statuses a subaccount can move between", func() {
+		for _, status := range []string{"active", "suspended"} {
+			_, errs := validateSubaccountStatus(status, "status")
+			Expect(errs).To(BeEmpty(), status)
+		}
+	})
+
+	It("rejects closing the subaccount", func() {
+		_, errs := validateSubaccountStatus("closed", "status")
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0]).To(MatchError(ContainSubstring("closing a subaccount is irreversible")))
+	})
+
+	It("rejects unknown statuses", func() {
+		_, errs := validateSubaccountStatus("Active", "status")
+		Expect(errs).To(HaveLen(1))
+		Expect(errs[0]).To(MatchError(ContainSubstring("must be either active or suspended")))
+	})
+})
+
+var _ = Describe("resourceTwilioSubaccountDelete", func() {
+	var fake *fakeTwilio
+
+	BeforeEach(func() {
+		fake = newFakeTwilio(map[string]string{
+			"GET /2010-04-01/Accounts/ACsub/IncomingPhoneNumbers.json": `{"incoming_phone_numbers": [{"sid": "PN123"}]}`,
+			"POST /2010-04-01/Accounts/ACsub.json":                     `{"sid": "ACsub"}`,
+		})
+	})
+
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	destroy := func(attributes map[string]interface{}) error {
+		d := resourceTwilioSubaccount().Data(nil)
+		for key, value := range attributes {
+			Expect(d.Set(key, value)).To(Succeed())
+		}
+		d.SetId("ACsub")
+		return resourceTwilioSubaccountDelete(d, fake.meta())
+	}
+
+	It("refuses to close a subaccount that still owns phone numbers", func() {
+		err := destroy(map[string]interface{}{"on_destroy": "close"})
+		Expect(err).To(MatchError(ContainSubstring("still owns 1 phone number(s)")))
+
+		Expect(fake.requestsMatching("POST", "Accounts/ACsub.json")).To(BeEmpty())
+	})
+
+	It("closes a subaccount that still owns phone numbers when force_close is set", func() {
+		Expect(destroy(map[string]interface{}{"on_destroy": "close", "force_close": true})).To(Succeed())
+
+		Expect(fake.forms["POST /2010-04-01/Accounts/ACsub.json"].Get("Status")).To(Equal("closed"))
+	})
+
+	It("suspends a subaccount that still owns phone numbers", func() {
+		Expect(destroy(map[string]interface{}{"on_destroy": "suspend"})).To(Succeed())
+
+		Expect(fake.forms["POST /2010-04-01/Accounts/ACsub.json"].Get("Status")).To(Equal("suspended"))
+	})
+
+	It("refuses to destroy a subaccount with deletion_protection enabled", func() {
+		err := destroy(map[string]interface{}{"deletion_protection": true})
+		Expect(err).To(MatchError(ContainSubstring("has deletion_protection enabled")))
+
+		Expect(fake.requests).To(BeEmpty())
+	})
+})
@@ -0,0 +1,671 @@
+package twilio
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/helper/validation"
+	"github.com/kevinburke/twilio-go"
+	"github.com/spf13/cast"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const tollfreeVerificationsPathPart = "Tollfree/Verifications"
+
+// tollfreeVerification is the verification of a toll-free number for messaging, as returned by the Messaging v1 API.
+type tollfreeVerification struct {
+	Sid                         string            `json:"sid"`
+	AccountSid                  string            `json:"account_sid"`
+	CustomerProfileSid          string            `json:"customer_profile_sid"`
+	TrustProductSid             string            `json:"trust_product_sid"`
+	RegulatedItemSid            string            `json:"regulated_item_sid"`
+	TollfreePhoneNumberSid      string            `json:"tollfree_phone_number_sid"`
+	BusinessName                string            `json:"business_name"`
+	BusinessWebsite             string            `json:"business_website"`
+	BusinessStreetAddress       string            `json:"business_street_address"`
+	BusinessStreetAddress2      string            `json:"business_street_address2"`
+	BusinessCity                string            `json:"business_city"`
+	BusinessStateProvinceRegion string            `json:"business_state_province_region"`
+	BusinessPostalCode          string            `json:"business_postal_code"`
+	BusinessCountry             string            `json:"business_country"`
+	BusinessContactFirstName    string            `json:"business_contact_first_name"`
+	BusinessContactLastName     string            `json:"business_contact_last_name"`
+	BusinessContactEmail        string            `json:"business_contact_email"`
+	BusinessContactPhone        string            `json:"business_contact_phone"`
+	NotificationEmail           string            `json:"notification_email"`
+	UseCaseCategories           []string          `json:"use_case_categories"`
+	UseCaseSummary              string            `json:"use_case_summary"`
+	ProductionMessageSample     string            `json:"production_message_sample"`
+	OptInImageUrls              []string          `json:"opt_in_image_urls"`
+	OptInType                   string            `json:"opt_in_type"`
+	MessageVolume               string            `json:"message_volume"`
+	AdditionalInformation       string            `json:"additional_information"`
+	ExternalReferenceID         string            `json:"external_reference_id"`
+	Status                      string            `json:"status"`
+	RejectionReason             string            `json:"rejection_reason"`
+	ErrorCode                   int               `json:"error_code"`
+	EditAllowed                 bool              `json:"edit_allowed"`
+	EditExpiration              string            `json:"edit_expiration"`
+	URL                         string            `json:"url"`
+	DateCreated                 twilio.TwilioTime `json:"date_created"`
+	DateUpdated                 twilio.TwilioTime `json:"date_updated"`
+}
+
+// tollfreeVerificationPendingStatuses are the statuses of a verification that Twilio has not decided on yet.
+var tollfreeVerificationPendingStatuses = []string{"PENDING_REVIEW", "IN_REVIEW"}
+
+// tollfreeVerificationAttributes maps the submitted string attributes to their API parameters.
+var tollfreeVerificationAttributes = map[string]string{
+	"business_name":                  "BusinessName",
+	"business_website":               "BusinessWebsite",
+	"business_street_address":        "BusinessStreetAddress",
+	"business_street_address2":       "BusinessStreetAddress2",
+	"business_city":                  "BusinessCity",
+	"business_state_province_region": "BusinessStateProvinceRegion",
+	"business_postal_code":           "BusinessPostalCode",
+	"business_country":               "BusinessCountry",
+	"business_contact_first_name":    "BusinessContactFirstName",
+	"business_contact_last_name":     "BusinessContactLastName",
+	"business_contact_email":         "BusinessContactEmail",
+	"business_contact_phone":         "BusinessContactPhone",
+	"notification_email":             "NotificationEmail",
+	"use_case_summary":               "UseCaseSummary",
+	"production_message_sample":      "ProductionMessageSample",
+	"opt_in_type":                    "OptInType",
+	"message_volume":                 "MessageVolume",
+	"additional_information":         "AdditionalInformation",
+	"external_reference_id":          "ExternalReferenceId",
+}
+
+func resourceTwilioTollfreeVerification() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceTwilioTollfreeVerificationCreate,
+		Read:   resourceTwilioTollfreeVerificationRead,
+		Update: resourceTwilioTollfreeVerificationUpdate,
+		Delete: resourceTwilioTollfreeVerificationDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+		},
+		CustomizeDiff: validateTollfreePhoneNumber,
+
+		Schema: map[string]*schema.Schema{
+			"tollfree_phone_number_sid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "SID of the toll-free number (PN...) to verify, e.g. the `sid` of a `twilio_phone_number` with `type = \"TollFree\"`.",
+			},
+			"customer_profile_sid": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "SID of an approved customer profile bundle (BU...) holding the business information.",
+			},
+			"business_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"business_website": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateURL,
+			},
+			"business_street_address": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"business_street_address2": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"business_city": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"business_state_province_region": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"business_postal_code": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"business_country": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ISO country code of the business, e.g. `US`.",
+			},
+			"business_contact_first_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"business_contact_last_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"business_contact_email": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"business_contact_phone": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"notification_email": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Email address Twilio notifies when the verification status changes.",
+			},
+			"use_case_categories": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Categories of messages sent from the number, e.g. `TWO_FACTOR_AUTHENTICATION` or `MARKETING`.",
+			},
+			"use_case_summary": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "How the number is used for messaging.",
+			},
+			"production_message_sample": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "An example of a message sent from the number.",
+			},
+			"opt_in_image_urls": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateURL},
+				Description: "URLs of screenshots or documents showing how end users opt in.",
+			},
+			"opt_in_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"VERBAL",
+					"WEB_FORM",
+					"PAPER_FORM",
+					"VIA_TEXT",
+					"MOBILE_QR_CODE",
+				}, false),
+				Description: "How end users opt in: `VERBAL`, `WEB_FORM`, `PAPER_FORM`, `VIA_TEXT` or `MOBILE_QR_CODE`.",
+			},
+			"message_volume": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Estimated monthly message volume, e.g. `1,000` or `10,000`.",
+			},
+			"additional_information": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"external_reference_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"wait_for_approval": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If `true`, submitting the verification waits until Twilio approves or rejects it. A first submission that is rejected or still in review once the create timeout elapses is reported through `status` and `rejection_reason`, while a resubmission fails the apply. Reviews can take days, so defaults to `false`.",
+			},
+			"sid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier (HH...) of this verification.",
+			},
+			"account_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"trust_product_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"regulated_item_sid": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The verification status: `PENDING_REVIEW`, `IN_REVIEW`, `TWILIO_APPROVED` or `TWILIO_REJECTED`.",
+			},
+			"rejection_reason": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Why the verification was rejected, if it was.",
+			},
+			"error_code": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The Twilio error code of the rejection, if any.",
+			},
+			"edit_allowed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether a rejected verification can still be edited and resubmitted.",
+			},
+			"edit_expiration": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"url": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_created": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"date_updated": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// validateTollfreePhoneNumber fails the plan of a new verification when `tollfree_phone_number_sid` is not a toll-free
+// number owned by the account, rather than letting Twilio reject the submission.
+func validateTollfreePhoneNumber(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" || !d.NewValueKnown("tollfree_phone_number_sid") {
+		return nil
+	}
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+	sid := d.Get("tollfree_phone_number_sid").(string)
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":      config.AccountSID,
+			"phone_number_sid": sid,
+		},
+	).Debug("START client.IncomingNumbers.Get")
+
+	ph, err := getIncomingPhoneNumber(context.TODO(), client, "", sid)
+	if err != nil {
+		return fmt.Errorf("Encountered an error when getting phone number SID %s: %s", sid, err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":      config.AccountSID,
+			"phone_number_sid": sid,
+		},
+	).Debug("END client.IncomingNumbers.Get")
+
+	// The type of a number is only exposed by the per-type lists
+	tollfreeNumbers, err := listIncomingPhoneNumbersOfType(context.TODO(), client, "", "TollFree", url.Values{"PhoneNumber": []string{string(ph.PhoneNumber)}})
+	if err != nil {
+		return fmt.Errorf("Encountered an error when listing toll-free phone numbers: %s", err)
+	}
+	for _, tollfreeNumber := range tollfreeNumbers {
+		if tollfreeNumber.Sid == sid {
+			return nil
+		}
+	}
+	return fmt.Errorf("Phone number %s (SID %s) is not a toll-free number and cannot be verified as one", ph.PhoneNumber, sid)
+}
+
+func getTollfreeVerification(ctx context.Context, client *twilio.Client, sid string) (*tollfreeVerification, error) {
+	verification := new(tollfreeVerification)
+	err := client.Message.GetResource(ctx, tollfreeVerificationsPathPart, sid, verification)
+	return verification, err
+}
+
+// makeTollfreeVerificationPayload builds the parameters shared by the creation and the resubmission of a verification.
+func makeTollfreeVerificationPayload(d *schema.ResourceData) url.Values {
+	params := make(url.Values)
+	for attribute, param := range tollfreeVerificationAttributes {
+		addIfNotEmpty(params, param, d.Get(attribute))
+	}
+	for _, category := range d.Get("use_case_categories").([]interface{}) {
+		params.Add("UseCaseCategories", cast.ToString(category))
+	}
+	for _, imageURL := range d.Get("opt_in_image_urls").([]interface{}) {
+		params.Add("OptInImageUrls", cast.ToString(imageURL))
+	}
+	return params
+}
+
+func mapTwilioTollfreeVerificationToTerraform(verification *tollfreeVerification, d *schema.ResourceData) error {
+	err := d.Set("sid", verification.Sid)
+	if err == nil {
+		err = d.Set("account_sid", verification.AccountSid)
+	}
+	if err == nil {
+		err = d.Set("tollfree_phone_number_sid", verification.TollfreePhoneNumberSid)
+	}
+	if err == nil {
+		err = d.Set("customer_profile_sid", verification.CustomerProfileSid)
+	}
+	if err == nil {
+		err = d.Set("trust_product_sid", verification.TrustProductSid)
+	}
+	if err == nil {
+		err = d.Set("regulated_item_sid", verification.RegulatedItemSid)
+	}
+	if err == nil {
+		err = d.Set("business_name", verification.BusinessName)
+	}
+	if err == nil {
+		err = d.Set("business_website", verification.BusinessWebsite)
+	}
+	if err == nil {
+		err = d.Set("business_street_address", verification.BusinessStreetAddress)
+	}
+	if err == nil {
+		err = d.Set("business_street_address2", verification.BusinessStreetAddress2)
+	}
+	if err == nil {
+		err = d.Set("business_city", verification.BusinessCity)
+	}
+	if err == nil {
+		err = d.Set("business_state_province_region", verification.BusinessStateProvinceRegion)
+	}
+	if err == nil {
+		err = d.Set("business_postal_code", verification.BusinessPostalCode)
+	}
+	if err == nil {
+		err = d.Set("business_country", verification.BusinessCountry)
+	}
+	if err == nil {
+		err = d.Set("business_contact_first_name", verification.BusinessContactFirstName)
+	}
+	if err == nil {
+		err = d.Set("business_contact_last_name", verification.BusinessContactLastName)
+	}
+	if err == nil {
+		err = d.Set("business_contact_email", verification.BusinessContactEmail)
+	}
+	if err == nil {
+		err = d.Set("business_contact_phone", verification.BusinessContactPhone)
+	}
+	if err == nil {
+		err = d.Set("notification_email", verification.NotificationEmail)
+	}
+	if err == nil {
+		err = d.Set("use_case_categories", verification.UseCaseCategories)
+	}
+	if err == nil {
+		err = d.Set("use_case_summary", verification.UseCaseSummary)
+	}
+	if err == nil {
+		err = d.Set("production_message_sample", verification.ProductionMessageSample)
+	}
+	if err == nil {
+		err = d.Set("opt_in_image_urls", verification.OptInImageUrls)
+	}
+	if err == nil {
+		err = d.Set("opt_in_type", verification.OptInType)
+	}
+	if err == nil {
+		err = d.Set("message_volume", verification.MessageVolume)
+	}
+	if err == nil {
+		err = d.Set("additional_information", verification.AdditionalInformation)
+	}
+	if err == nil {
+		err = d.Set("external_reference_id", verification.ExternalReferenceID)
+	}
+	if err == nil {
+		err = d.Set("status", verification.Status)
+	}
+	if err == nil {
+		err = d.Set("rejection_reason", verification.RejectionReason)
+	}
+	if err == nil {
+		err = d.Set("error_code", verification.ErrorCode)
+	}
+	if err == nil {
+		err = d.Set("edit_allowed", verification.EditAllowed)
+	}
+	if err == nil {
+		err = d.Set("edit_expiration", verification.EditExpiration)
+	}
+	if err == nil {
+		err = d.Set("url", verification.URL)
+	}
+	if err == nil && verification.DateCreated.Valid {
+		err = d.Set("date_created", verification.DateCreated.Time.Format("2006-01-02T15:04:05-07:00"))
+	}
+	if err == nil && verification.DateUpdated.Valid {
+		err = d.Set("date_updated", verification.DateUpdated.Time.Format("2006-01-02T15:04:05-07:00"))
+	}
+	return err
+}
+
+// waitForTollfreeVerification polls the verification in the resource ID until Twilio decides on it, mapping it on every
+// poll. A rejection, or a review still pending once timeout elapses, only fails when failOnRejection is set: failing on
+// create would taint the verification and submit it again on the next apply, so it is left to `status` and
+// `rejection_reason` instead.
+func waitForTollfreeVerification(d *schema.ResourceData, meta interface{}, timeout time.Duration, failOnRejection bool) error {
+	client := meta.(*TerraformTwilioContext).client
+	ctx := context.TODO()
+	sid := d.Id()
+
+	var verification *tollfreeVerification
+	var refreshErr error
+	status, err := waitForStatus(fmt.Sprintf("toll-free verification %s", sid), timeout, tollfreeVerificationPendingStatuses, func() (string, error) {
+		latest, err := getTollfreeVerification(ctx, client, sid)
+		if err != nil {
+			refreshErr = fmt.Errorf("Encountered an error when getting toll-free verification SID %s: %s", sid, err)
+		} else if err := mapTwilioTollfreeVerificationToTerraform(latest, d); err != nil {
+			refreshErr = fmt.Errorf("Encountered an error while mapping Twilio API result to terraform: %s", err)
+		}
+		if refreshErr != nil {
+			return "", refreshErr
+		}
+		verification = latest
+		return verification.Status, nil
+	})
+	if refreshErr != nil {
+		return refreshErr
+	}
+
+	if err == nil && !strings.EqualFold(status, "TWILIO_APPROVED") {
+		err = fmt.Errorf("Toll-free verification %s ended in status %s (error code %d): %s", sid, status, verification.ErrorCode, verification.RejectionReason)
+	}
+	if err != nil && !failOnRejection {
+		log.WithFields(
+			log.Fields{
+				"verification_sid": sid,
+				"status":           status,
+			},
+		).WithError(err).Warn("Toll-free verification was not approved, see status and rejection_reason")
+		return nil
+	}
+	return err
+}
+
+func resourceTwilioTollfreeVerificationCreate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioTollfreeVerificationCreate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	phoneNumberSid := d.Get("tollfree_phone_number_sid").(string)
+
+	params := makeTollfreeVerificationPayload(d)
+	params.Set("TollfreePhoneNumberSid", phoneNumberSid)
+	addIfNotEmpty(params, "CustomerProfileSid", d.Get("customer_profile_sid"))
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":      config.AccountSID,
+			"phone_number_sid": phoneNumberSid,
+		},
+	).Debug("START client.Message.TollfreeVerifications.Create")
+
+	verification := new(tollfreeVerification)
+	if err := client.Message.CreateResource(context.TODO(), tollfreeVerificationsPathPart, params, verification); err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid":      config.AccountSID,
+				"phone_number_sid": phoneNumberSid,
+			},
+		).WithError(err).Error("ERROR client.Message.TollfreeVerifications.Create")
+
+		return fmt.Errorf("Encountered an error when submitting toll-free verification for phone number SID %s: %s", phoneNumberSid, err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":      config.AccountSID,
+			"phone_number_sid": phoneNumberSid,
+			"verification_sid": verification.Sid,
+		},
+	).Debug("END client.Message.TollfreeVerifications.Create")
+
+	d.SetId(verification.Sid)
+
+	if err := mapTwilioTollfreeVerificationToTerraform(verification, d); err != nil {
+		return fmt.Errorf("Encountered error while reading result for toll-free verification SID %s and mapping it to TF: %s", verification.Sid, err)
+	}
+
+	if d.Get("wait_for_approval").(bool) {
+		return waitForTollfreeVerification(d, meta, d.Timeout(schema.TimeoutCreate), false)
+	}
+	return nil
+}
+
+func resourceTwilioTollfreeVerificationRead(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioTollfreeVerificationRead")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":      config.AccountSID,
+			"verification_sid": sid,
+		},
+	).Debug("START client.Message.TollfreeVerifications.Get")
+
+	verification, err := getTollfreeVerification(context.TODO(), client, sid)
+	if isNotFoundError(err) {
+		d.SetId("")
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("Encountered an error when getting toll-free verification SID %s: %s", sid, err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":      config.AccountSID,
+			"verification_sid": sid,
+			"status":           verification.Status,
+		},
+	).Debug("END client.Message.TollfreeVerifications.Get")
+
+	if err := mapTwilioTollfreeVerificationToTerraform(verification, d); err != nil {
+		return fmt.Errorf("Encountered an error while mapping Twilio API result to terraform: %s", err)
+	}
+	return nil
+}
+
+// resourceTwilioTollfreeVerificationUpdate edits the verification, which resubmits it for review. Twilio only accepts
+// edits of rejected verifications while `edit_allowed` is true.
+func resourceTwilioTollfreeVerificationUpdate(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioTollfreeVerificationUpdate")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	sid := d.Id()
+
+	changed := false
+	for attribute := range tollfreeVerificationAttributes {
+		changed = changed || d.HasChange(attribute)
+	}
+	changed = changed || d.HasChange("use_case_categories") || d.HasChange("opt_in_image_urls")
+
+	if changed {
+		log.WithFields(
+			log.Fields{
+				"account_sid":      config.AccountSID,
+				"verification_sid": sid,
+			},
+		).Debug("START client.Message.TollfreeVerifications.Update")
+
+		verification := new(tollfreeVerification)
+		if err := client.Message.UpdateResource(context.TODO(), tollfreeVerificationsPathPart, sid, makeTollfreeVerificationPayload(d), verification); err != nil {
+			log.WithFields(
+				log.Fields{
+					"account_sid":      config.AccountSID,
+					"verification_sid": sid,
+				},
+			).WithError(err).Error("ERROR client.Message.TollfreeVerifications.Update")
+
+			return fmt.Errorf("Failed to update toll-free verification SID %s: %s", sid, err)
+		}
+
+		log.WithFields(
+			log.Fields{
+				"account_sid":      config.AccountSID,
+				"verification_sid": sid,
+				"status":           verification.Status,
+			},
+		).Debug("END client.Message.TollfreeVerifications.Update")
+
+		if err := mapTwilioTollfreeVerificationToTerraform(verification, d); err != nil {
+			return fmt.Errorf("Encountered an error while mapping Twilio API result to terraform: %s", err)
+		}
+	}
+
+	if d.Get("wait_for_approval").(bool) {
+		return waitForTollfreeVerification(d, meta, d.Timeout(schema.TimeoutUpdate), changed)
+	}
+	return nil
+}
+
+func resourceTwilioTollfreeVerificationDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Debug("ENTER resourceTwilioTollfreeVerificationDelete")
+
+	client := meta.(*TerraformTwilioContext).client
+	config := meta.(*TerraformTwilioContext).configuration
+
+	sid := d.Id()
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":      config.AccountSID,
+			"verification_sid": sid,
+		},
+	).Debug("START client.Message.TollfreeVerifications.Delete")
+
+	if err := client.Message.DeleteResource(context.TODO(), tollfreeVerificationsPathPart, sid); err != nil {
+		log.WithFields(
+			log.Fields{
+				"account_sid":      config.AccountSID,
+				"verification_sid": sid,
+			},
+		).WithError(err).Error("ERROR client.Message.TollfreeVerifications.Delete")
+
+		return fmt.Errorf("Encountered an error when deleting toll-free verification SID %s: %s", sid, err)
+	}
+
+	log.WithFields(
+		log.Fields{
+			"account_sid":      config.AccountSID,
+			"verification_sid": sid,
+		},
+	).Debug("END client.Message.TollfreeVerifications.Delete")
+
+	return nil
+}
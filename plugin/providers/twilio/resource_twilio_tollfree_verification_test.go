@@ -0,0 +1,96 @@
+package twilio
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("validateTollfreePhoneNumber", func() {
+	var fake *fakeTwilio
+
+	BeforeEach(func() {
+		fake = newFakeTwilio(map[string]string{
+			"GET /2010-04-01/Accounts/AC123/IncomingPhoneNumbers/PN123.json":    `{"sid": "PN123", "phone_number": "+448081234567"}`,
+			"GET /2010-04-01/Accounts/AC123/IncomingPhoneNumbers/PN456.json":    `{"sid": "PN456", "phone_number": "+15551234567"}`,
+			"GET /2010-04-01/Accounts/AC123/IncomingPhoneNumbers/TollFree.json": `{"incoming_phone_numbers": [{"sid": "PN123", "phone_number": "+448081234567"}]}`,
+		})
+	})
+
+	AfterEach(func() {
+		fake.Close()
+	})
+
+	plan := func(sid string) error {
+		r := resourceTwilioTollfreeVerification()
+		_, err := r.Diff(nil, terraform.NewResourceConfigRaw(map[string]interface{}{"tollfree_phone_number_sid": sid}), fake.meta())
+		return err
+	}
+
+	It("accepts a number Twilio lists as toll-free, whatever its country", func() {
+		Expect(plan("PN123")).To(Succeed())
+		Expect(fake.forms["GET /2010-04-01/Accounts/AC123/IncomingPhoneNumbers/TollFree.json"].Get("PhoneNumber")).To(Equal("+448081234567"))
+	})
+
+	It("rejects a number Twilio does not list as toll-free", func() {
+		Expect(plan("PN456")).To(MatchError(ContainSubstring("is not a toll-free number")))
+	})
+})
+
+var _ = Describe("waitForTollfreeVerification", func() {
+	var fake *fakeTwilio
+	var interval time.Duration
+
+	BeforeEach(func() {
+		interval = statusPollInterval
+		statusPollInterval = time.Millisecond
+
+		fake = newFakeTwilio(map[string]string{
+			"POST /v1/Tollfree/Verifications":       `{"sid": "HH123", "status": "PENDING_REVIEW"}`,
+			"POST /v1/Tollfree/Verifications/HH123": `{"sid": "HH123", "status": "PENDING_REVIEW"}`,
+			"GET /v1/Tollfree/Verifications/HH123":  `{"sid": "HH123", "status": "TWILIO_REJECTED", "rejection_reason": "Opt-in is missing", "error_code": 30498}`,
+		})
+	})
+
+	AfterEach(func() {
+		statusPollInterval = interval
+		fake.Close()
+	})
+
+	It("records a rejected submission in state without failing the create", func() {
+		d := resourceTwilioTollfreeVerification().Data(nil)
+		Expect(d.Set("tollfree_phone_number_sid", "PN123")).To(Succeed())
+		Expect(d.Set("wait_for_approval", true)).To(Succeed())
+
+		Expect(resourceTwilioTollfreeVerificationCreate(d, fake.meta())).To(Succeed())
+
+		Expect(d.Id()).To(Equal("HH123"))
+		Expect(d.Get("status")).To(Equal("TWILIO_REJECTED"))
+		Expect(d.Get("rejection_reason")).To(Equal("Opt-in is missing"))
+	})
+
+	It("fails a rejected resubmission", func() {
+		r := resourceTwilioTollfreeVerification()
+		state := r.Data(nil)
+		Expect(state.Set("tollfree_phone_number_sid", "PN123")).To(Succeed())
+		Expect(state.Set("business_name", "Old name")).To(Succeed())
+		Expect(state.Set("wait_for_approval", true)).To(Succeed())
+		state.SetId("HH123")
+
+		diff, err := r.Diff(state.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+			"tollfree_phone_number_sid": "PN123",
+			"business_name":             "New name",
+			"wait_for_approval":         true,
+		}), fake.meta())
+		Expect(err).NotTo(HaveOccurred())
+		d, err := schema.InternalMap(r.Schema).Data(state.State(), diff)
+		Expect(err).NotTo(HaveOccurred())
+
+		err = resourceTwilioTollfreeVerificationUpdate(d, fake.meta())
+		Expect(err).To(MatchError(ContainSubstring("ended in status TWILIO_REJECTED (error code 30498): Opt-in is missing")))
+		Expect(fake.requestsMatching("POST", "Verifications/HH123")).To(HaveLen(1))
+	})
+})
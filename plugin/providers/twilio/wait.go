@@ -0,0 +1,66 @@
+package twilio
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// statusPollInterval is how long waitForStatus sleeps between two polls.
+var statusPollInterval = 15 * time.Second
+
+// waitForStatus calls refresh until the status it returns is no longer one of pending, then returns that status. An
+// error is returned if refresh fails or the status is still pending once timeout has elapsed.
+func waitForStatus(description string, timeout time.Duration, pending []string, refresh func() (string, error)) (string, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		status, err := refresh()
+		if err != nil {
+			return "", err
+		}
+
+		isPending := false
+		for _, p := range pending {
+			if strings.EqualFold(status, p) {
+				isPending = true
+				break
+			}
+		}
+		if !isPending {
+			return status, nil
+		}
+
+		if time.Now().After(deadline) {
+			return status, fmt.Errorf("Timed out after %s waiting for %s to leave status %s", timeout, description, status)
+		}
+
+		log.WithFields(
+			log.Fields{
+				"status": status,
+			},
+		).Debug(fmt.Sprintf("Waiting for %s", description))
+
+		time.Sleep(statusPollInterval)
+	}
+}
+
+// waitForApproval waits like waitForStatus for a review to complete. A review that ends in any status other than
+// approved is only logged, since failing would taint a paid registration and register it again on the next apply;
+// the caller's refresh is expected to map the rejection into state. Refresh errors and timeouts are returned.
+func waitForApproval(description string, timeout time.Duration, pending []string, approved string, refresh func() (string, error)) error {
+	status, err := waitForStatus(description, timeout, pending, refresh)
+	if err != nil {
+		return err
+	}
+
+	if !strings.EqualFold(status, approved) {
+		log.WithFields(
+			log.Fields{
+				"status": status,
+			},
+		).Warn(fmt.Sprintf("%s ended in status %s rather than %s, see its state for the reasons", description, status, approved))
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+package twilio
+
+import (
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("waitForApproval", func() {
+	var interval time.Duration
+
+	BeforeEach(func() {
+		interval = statusPollInterval
+		statusPollInterval = time.Millisecond
+	})
+
+	AfterEach(func() {
+		statusPollInterval = interval
+	})
+
+	statuses := func(statuses ...string) func() (string, error) {
+		return func() (string, error) {
+			status := statuses[0]
+			if len(statuses) > 1 {
+				statuses = statuses[1:]
+			}
+			return status, nil
+		}
+	}
+
+	It("waits until the review is approved", func() {
+		Expect(waitForApproval("brand", time.Minute, []string{"PENDING"}, "APPROVED", statuses("PENDING", "APPROVED"))).To(Succeed())
+	})
+
+	It("tolerates a review that ends in another terminal status", func() {
+		Expect(waitForApproval("brand", time.Minute, []string{"PENDING"}, "APPROVED", statuses("PENDING", "FAILED"))).To(Succeed())
+	})
+
+	It("returns errors from refreshing the status", func() {
+		err := waitForApproval("brand", time.Minute, []string{"PENDING"}, "APPROVED", func() (string, error) {
+			return "", errors.New("internal error")
+		})
+		Expect(err).To(MatchError("internal error"))
+	})
+
+	It("returns an error when the review is still pending once the timeout elapses", func() {
+		err := waitForApproval("brand", 0, []string{"PENDING"}, "APPROVED", statuses("PENDING"))
+		Expect(err).To(MatchError(ContainSubstring("Timed out after 0s waiting for brand to leave status PENDING")))
+	})
+})